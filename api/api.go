@@ -1,102 +1,322 @@
 package api
 
 import (
-	"github.com/aws/aws-sdk-go/service/rds"
-	"github.com/aws/aws-sdk-go/aws"
+	"context"
+	"encoding/json"
 	"errors"
-	"time"
-	"math/rand"
 	"fmt"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"math"
 	"strings"
+	"time"
 )
 
 type RDSService interface {
-	DescribeDBSubnetGroups(input *rds.DescribeDBSubnetGroupsInput) (*rds.DescribeDBSubnetGroupsOutput, error)
+	DescribeDBSubnetGroupsPages(input *rds.DescribeDBSubnetGroupsInput, fn func(*rds.DescribeDBSubnetGroupsOutput, bool) bool) error
+	ListTagsForResource(input *rds.ListTagsForResourceInput) (*rds.ListTagsForResourceOutput, error)
 	CreateDBInstance(input *rds.CreateDBInstanceInput) (*rds.CreateDBInstanceOutput, error)
 	DescribeDBInstances(input *rds.DescribeDBInstancesInput) (*rds.DescribeDBInstancesOutput, error)
 	ModifyDBInstance(input *rds.ModifyDBInstanceInput) (*rds.ModifyDBInstanceOutput, error)
-	WaitUntilDBInstanceAvailable(input *rds.DescribeDBInstancesInput) error
+	WaitUntilDBInstanceAvailableWithContext(ctx aws.Context, input *rds.DescribeDBInstancesInput, opts ...request.WaiterOption) error
+	DeleteDBInstance(input *rds.DeleteDBInstanceInput) (*rds.DeleteDBInstanceOutput, error)
+	WaitUntilDBInstanceDeletedWithContext(ctx aws.Context, input *rds.DescribeDBInstancesInput, opts ...request.WaiterOption) error
+	CreateDBSnapshot(input *rds.CreateDBSnapshotInput) (*rds.CreateDBSnapshotOutput, error)
+	DescribeDBSnapshots(input *rds.DescribeDBSnapshotsInput) (*rds.DescribeDBSnapshotsOutput, error)
+	DeleteDBSnapshot(input *rds.DeleteDBSnapshotInput) (*rds.DeleteDBSnapshotOutput, error)
+	RestoreDBInstanceFromDBSnapshot(input *rds.RestoreDBInstanceFromDBSnapshotInput) (*rds.RestoreDBInstanceFromDBSnapshotOutput, error)
+	RestoreDBInstanceToPointInTime(input *rds.RestoreDBInstanceToPointInTimeInput) (*rds.RestoreDBInstanceToPointInTimeOutput, error)
+	StopDBInstance(input *rds.StopDBInstanceInput) (*rds.StopDBInstanceOutput, error)
+	StartDBInstance(input *rds.StartDBInstanceInput) (*rds.StartDBInstanceOutput, error)
+	RebootDBInstance(input *rds.RebootDBInstanceInput) (*rds.RebootDBInstanceOutput, error)
+	CreateDBCluster(input *rds.CreateDBClusterInput) (*rds.CreateDBClusterOutput, error)
+	DescribeDBClusters(input *rds.DescribeDBClustersInput) (*rds.DescribeDBClustersOutput, error)
+	ModifyDBCluster(input *rds.ModifyDBClusterInput) (*rds.ModifyDBClusterOutput, error)
+	DeleteDBCluster(input *rds.DeleteDBClusterInput) (*rds.DeleteDBClusterOutput, error)
+	DescribeDBClusterSnapshots(input *rds.DescribeDBClusterSnapshotsInput) (*rds.DescribeDBClusterSnapshotsOutput, error)
+	CreateDBInstanceReadReplica(input *rds.CreateDBInstanceReadReplicaInput) (*rds.CreateDBInstanceReadReplicaOutput, error)
+	PromoteReadReplica(input *rds.PromoteReadReplicaInput) (*rds.PromoteReadReplicaOutput, error)
+	DescribeDBEngineVersions(input *rds.DescribeDBEngineVersionsInput) (*rds.DescribeDBEngineVersionsOutput, error)
+	CreateDBParameterGroup(input *rds.CreateDBParameterGroupInput) (*rds.CreateDBParameterGroupOutput, error)
+	DescribeDBParametersPages(input *rds.DescribeDBParametersInput, fn func(*rds.DescribeDBParametersOutput, bool) bool) error
+	ModifyDBParameterGroup(input *rds.ModifyDBParameterGroupInput) (*rds.DBParameterGroupNameMessage, error)
+	DeleteDBParameterGroup(input *rds.DeleteDBParameterGroupInput) (*rds.DeleteDBParameterGroupOutput, error)
 }
 
-
 type CfRDSApi struct {
-	Svc RDSService
+	Svc          RDSService
+	EC2          EC2Service
+	IAM          IAMService
+	Region       string
+	PollInterval time.Duration
+	// Credentials sign the IAM auth tokens of AuthToken.
+	Credentials *credentials.Credentials
 }
 
 type DBInstance struct {
-	ARN string `json:"arn,omitempty"`
+	ARN          string `json:"arn,omitempty"`
 	InstanceName string `json:"instance_id,omitempty"`
-	ResourceID string `json:"resource_id,omitempty"`
-	Username string `json:"username,omitempty"`
-	Password string `json:"password,omitempty"`
-	DBName string `json:"database,omitempty"`
-	DBURI string `json:"uri,omitempty"`
-
-	SecGroups []*rds.VpcSecurityGroupMembership `json:"-"`
-	SubnetGroup *rds.DBSubnetGroup `json:"-"`
-	Engine string `json:"-"`
-	InstanceClass string `json:"-"`
-	Storage int64 `json:"-"`
-	AZ string `json:"-"`
-	Port int64 `json:"-"`
-}
-
-func (f *CfRDSApi) GetSubnetGroups() ([]*rds.DBSubnetGroup, error) {
-	subnetGroupsResp, err := f.Svc.DescribeDBSubnetGroups(&rds.DescribeDBSubnetGroupsInput{
-		Filters: []*rds.Filter{
-			{
-				Name: aws.String("*"),
-				Values: []*string{
-					aws.String("*"),
-				},
-			},
-		},
-		Marker:     aws.String("String"),
-		MaxRecords: aws.Int64(20),
+	ResourceID   string `json:"resource_id,omitempty"`
+	Username     string `json:"username,omitempty"`
+	Password     string `json:"password,omitempty"`
+	DBName       string `json:"database,omitempty"`
+	DBURI        string `json:"uri,omitempty"`
+	JDBCURI      string `json:"jdbcUrl,omitempty"`
+	Address      string `json:"hostname,omitempty"`
+	Port         int64  `json:"port,omitempty"`
+
+	// ClusterName is the DB cluster of Aurora instances. Their URIs point at
+	// the writer endpoint of the cluster, their read URIs at its reader
+	// endpoint, which balances the connections across the members.
+	ClusterName   string `json:"cluster_id,omitempty"`
+	ReaderAddress string `json:"reader_hostname,omitempty"`
+	ReadURI       string `json:"read_uri,omitempty"`
+	// Members is the number of instances to create in a new DB cluster.
+	Members int `json:"-"`
+	// ReplicaSource is the instance a read replica replicates, by name or
+	// ARN.
+	ReplicaSource string `json:"replica_of,omitempty"`
+	// IAMAuth enables IAM database authentication on a new instance. Apps
+	// then log in with IAM auth tokens for the role RoleARN, which only hold
+	// in Region.
+	IAMAuth bool   `json:"-"`
+	Region  string `json:"region,omitempty"`
+	RoleARN string `json:"role_arn,omitempty"`
+
+	SecGroups     []*rds.VpcSecurityGroupMembership `json:"-"`
+	SubnetGroup   *rds.DBSubnetGroup                `json:"-"`
+	Engine        string                            `json:"-"`
+	InstanceClass string                            `json:"-"`
+	Storage       int64                             `json:"-"`
+	IOPS          int64                             `json:"-"`
+	AZ            string                            `json:"-"`
+	Status        string                            `json:"-"`
+
+	EngineVersion           string                      `json:"-"`
+	MultiAZ                 bool                        `json:"-"`
+	PubliclyAccessible      bool                        `json:"-"`
+	AutoMinorVersionUpgrade bool                        `json:"-"`
+	BackupRetention         int64                       `json:"-"`
+	MaintenanceWindow       string                      `json:"-"`
+	PendingModifications    *rds.PendingModifiedValues  `json:"-"`
+	ParameterGroup          *rds.DBParameterGroupStatus `json:"-"`
+
+	// Parameters are the DB parameters to set, on a new instance, in a DB
+	// parameter group dedicated to it.
+	Parameters map[string]string `json:"-"`
+
+	// Tags are the tags of the instance, which also go on every resource
+	// created along with it.
+	Tags map[string]string `json:"-"`
+}
+
+// MarshalJSON adds the database name under "name" as well, which is where
+// buildpacks look for it. Instances logged into with IAM auth tokens only
+// have their endpoint, user, region and role written.
+func (instance DBInstance) MarshalJSON() ([]byte, error) {
+	if instance.RoleARN != "" {
+		return json.Marshal(struct {
+			Address  string `json:"hostname"`
+			Port     int64  `json:"port"`
+			Username string `json:"username"`
+			Region   string `json:"region"`
+			RoleARN  string `json:"role_arn"`
+		}{instance.Address, instance.Port, instance.Username, instance.Region, instance.RoleARN})
+	}
+
+	type credentials DBInstance
+	return json.Marshal(struct {
+		credentials
+		Name string `json:"name,omitempty"`
+	}{credentials(instance), instance.DBName})
+}
+
+// SubnetGroupFilter narrows down the DB subnet groups GetSubnetGroups
+// returns. Empty fields match every subnet group.
+type SubnetGroupFilter struct {
+	VPC string
+	// Tags are the tags, keyed by name, a subnet group must carry.
+	Tags map[string]string
+}
+
+// SubnetGroup is a DB subnet group along with the availability zones it
+// covers.
+type SubnetGroup struct {
+	*rds.DBSubnetGroup
+
+	// AvailabilityZones are the distinct availability zones of the active
+	// subnets.
+	AvailabilityZones []string
+	ActiveSubnets     int
+}
+
+// GetSubnetGroups returns every DB subnet group in the region matching the
+// filter, reading all pages of DescribeDBSubnetGroups. RDS does not support
+// filters on subnet groups, so they are applied here.
+func (f *CfRDSApi) GetSubnetGroups(filter SubnetGroupFilter) ([]*SubnetGroup, error) {
+	dbSubnetGroups := []*rds.DBSubnetGroup{}
+	err := f.Svc.DescribeDBSubnetGroupsPages(&rds.DescribeDBSubnetGroupsInput{}, func(page *rds.DescribeDBSubnetGroupsOutput, lastPage bool) bool {
+		dbSubnetGroups = append(dbSubnetGroups, page.DBSubnetGroups...)
+		return true
 	})
 	if err != nil {
-		if strings.Contains(err.Error(), "NoCredentialProviders") {
-			return []*rds.DBSubnetGroup{}, errors.New("No valid AWS credentials found. Please see this document for help configuring the AWS SDK: https://github.com/aws/aws-sdk-go#configuring-credentials")
-		}
-		return []*rds.DBSubnetGroup{}, err
+		return nil, credentialsError(err)
 	}
 
-	subnetGroups := subnetGroupsResp.DBSubnetGroups
-	if len(subnetGroups) == 0 {
-		return subnetGroups, errors.New("Error: did not find any DB subnet groups to create RDS instance in")
+	subnetGroups := []*SubnetGroup{}
+	for _, dbSubnetGroup := range dbSubnetGroups {
+		if filter.VPC != "" && aws.StringValue(dbSubnetGroup.VpcId) != filter.VPC {
+			continue
+		}
+		if len(filter.Tags) > 0 {
+			matches, err := f.hasTags(aws.StringValue(dbSubnetGroup.DBSubnetGroupArn), filter.Tags)
+			if err != nil {
+				return nil, err
+			}
+			if !matches {
+				continue
+			}
+		}
+
+		subnetGroup := &SubnetGroup{
+			DBSubnetGroup:     dbSubnetGroup,
+			AvailabilityZones: AvailabilityZones(dbSubnetGroup),
+		}
+		for _, subnet := range dbSubnetGroup.Subnets {
+			if aws.StringValue(subnet.SubnetStatus) == "Active" {
+				subnetGroup.ActiveSubnets++
+			}
+		}
+		subnetGroups = append(subnetGroups, subnetGroup)
 	}
 
 	return subnetGroups, nil
 }
 
-func (f *CfRDSApi) CreateInstance(instance *DBInstance) (chan error, error) {
-	dbName := GenerateRandomString()
-	dbPassword := GenerateRandomAlphanumericString()
+// AvailabilityZone returns the availability zone of the first active subnet
+// in the subnet group, or an empty string if it has none.
+func AvailabilityZone(subnetGroup *rds.DBSubnetGroup) string {
+	for _, subnet := range subnetGroup.Subnets {
+		if subnet.SubnetAvailabilityZone == nil || aws.StringValue(subnet.SubnetStatus) != "Active" {
+			continue
+		}
+		return aws.StringValue(subnet.SubnetAvailabilityZone.Name)
+	}
+	return ""
+}
+
+// AvailabilityZones returns the distinct availability zones of the active
+// subnets in the subnet group.
+func AvailabilityZones(subnetGroup *rds.DBSubnetGroup) []string {
+	zones := []string{}
+	seen := map[string]bool{}
+	for _, subnet := range subnetGroup.Subnets {
+		if subnet.SubnetAvailabilityZone == nil || aws.StringValue(subnet.SubnetStatus) != "Active" {
+			continue
+		}
+		zone := aws.StringValue(subnet.SubnetAvailabilityZone.Name)
+		if !seen[zone] {
+			seen[zone] = true
+			zones = append(zones, zone)
+		}
+	}
+	return zones
+}
 
-	createDBInstanceResp, err := f.Svc.CreateDBInstance(&rds.CreateDBInstanceInput{
+// CreateInstance creates the RDS instance and waits until it is available,
+// or until ctx is done. The VPC security groups in instance.SecGroups are
+// attached to it; without any, RDS attaches the default group of the VPC.
+// Aurora engines get a DB cluster with instance.Members instances instead.
+// instance.Parameters go in a DB parameter group created for the instance
+// beforehand, which is then recorded in instance.ParameterGroup.
+// instance.ARN is only set once RDS has accepted to create the instance.
+func (f *CfRDSApi) CreateInstance(ctx context.Context, instance *DBInstance) error {
+	engine, err := LookupEngine(instance.Engine)
+	if err != nil {
+		return err
+	}
+	err = engine.ValidateUsername(instance.Username)
+	if err != nil {
+		return err
+	}
+	if instance.Port == 0 {
+		instance.Port = engine.DefaultPort
+	}
+
+	dbName := ""
+	if engine.DBNameRules != nil {
+		dbName, err = GenerateDBName(instance.Engine)
+		if err != nil {
+			return err
+		}
+	}
+	dbPassword, err := GeneratePassword(instance.Engine)
+	if err != nil {
+		return err
+	}
+	if IsAurora(instance.Engine) {
+		return f.createCluster(ctx, instance, dbName, dbPassword)
+	}
+
+	input := &rds.CreateDBInstanceInput{
 		DBInstanceClass:         aws.String(instance.InstanceClass),
 		DBInstanceIdentifier:    aws.String(instance.InstanceName),
 		Engine:                  aws.String(instance.Engine),
 		AllocatedStorage:        aws.Int64(instance.Storage),
-		AutoMinorVersionUpgrade: aws.Bool(true),
-		AvailabilityZone:        aws.String(instance.AZ),
+		AutoMinorVersionUpgrade: aws.Bool(instance.AutoMinorVersionUpgrade),
 		CopyTagsToSnapshot:      aws.Bool(true),
-		DBName:                  aws.String(dbName),
 		DBSubnetGroupName:       instance.SubnetGroup.DBSubnetGroupName,
 		MasterUserPassword:      aws.String(dbPassword),
 		MasterUsername:          aws.String(instance.Username),
-		MultiAZ:                 aws.Bool(false),
+		MultiAZ:                 aws.Bool(instance.MultiAZ),
 		Port:                    aws.Int64(instance.Port),
-		PubliclyAccessible:      aws.Bool(true),
-	})
+		PubliclyAccessible:      aws.Bool(instance.PubliclyAccessible),
+		Tags:                    rdsTags(instance.Tags),
+	}
+	if dbName != "" {
+		input.DBName = aws.String(dbName)
+	}
+	if instance.IAMAuth {
+		input.EnableIAMDatabaseAuthentication = aws.Bool(true)
+	}
+	if len(instance.Parameters) > 0 {
+		groupName := ParameterGroupName(instance.InstanceName)
+		created, err := f.createParameterGroup(instance, groupName)
+		if err != nil {
+			return err
+		}
+		// The group may belong to a service of the same name elsewhere, so
+		// it is neither taken over nor cleaned up.
+		if !created {
+			return fmt.Errorf("Error: DB parameter group %s already exists", groupName)
+		}
+		instance.ParameterGroup = &rds.DBParameterGroupStatus{
+			DBParameterGroupName: aws.String(groupName),
+		}
+		_, _, err = f.modifyParameterGroup(groupName, instance.Engine, instance.Parameters)
+		if err != nil {
+			return err
+		}
+		input.DBParameterGroupName = aws.String(groupName)
+	}
+	// RDS picks the availability zones of Multi-AZ instances itself.
+	if instance.AZ != "" && !instance.MultiAZ {
+		input.AvailabilityZone = aws.String(instance.AZ)
+	}
+	for _, secGroup := range instance.SecGroups {
+		input.VpcSecurityGroupIds = append(input.VpcSecurityGroupIds, secGroup.VpcSecurityGroupId)
+	}
+
+	createDBInstanceResp, err := f.Svc.CreateDBInstance(input)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	secGroups := createDBInstanceResp.DBInstance.VpcSecurityGroups
 	if len(secGroups) == 0 {
-		err = errors.New("Error: do not have any VPC security groups to associate with RDS instance")
-		return nil, err
+		return errors.New("Error: do not have any VPC security groups to associate with RDS instance")
 	}
 
 	instance.ARN = *createDBInstanceResp.DBInstance.DBInstanceArn
@@ -105,106 +325,177 @@ func (f *CfRDSApi) CreateInstance(instance *DBInstance) (chan error, error) {
 	instance.Password = dbPassword
 	instance.DBName = dbName
 
-	errChan := make(chan error, 1)
-	go f.waitForInstance(instance, errChan, false)
-
-	return errChan, nil
+	return f.waitForInstance(ctx, instance, false)
 }
 
-func (f *CfRDSApi) RefreshInstance(instance *DBInstance) chan error {
-	errChan := make(chan error, 1)
+// RefreshInstance reads the RDS instance back from AWS, waits until it is
+// available and resets its master password. For instances with IAM database
+// authentication it also waits for the new password to apply, so that the DB
+// user of the IAM role can be set up with it.
+func (f *CfRDSApi) RefreshInstance(ctx context.Context, instance *DBInstance) error {
 	describeDBInstancesResp, err := f.Svc.DescribeDBInstances(&rds.DescribeDBInstancesInput{
 		DBInstanceIdentifier: aws.String(instance.InstanceName),
 	})
 	if err != nil {
-		if strings.Contains(err.Error(), "NoCredentialProviders") {
-			err = errors.New("No valid AWS credentials found. Please see this document for help configuring the AWS SDK: https://github.com/aws/aws-sdk-go#configuring-credentials")
-		}
-
-		errChan <- err
-		return errChan
+		return credentialsError(err)
 	}
 
 	dbInstances := describeDBInstancesResp.DBInstances
 	if len(dbInstances) == 0 {
-		errChan <- fmt.Errorf("Could not find db instance %s", instance.InstanceName)
-		return errChan
+		return fmt.Errorf("Could not find db instance %s", instance.InstanceName)
+	}
+
+	if dbInstances[0].DBClusterIdentifier != nil {
+		instance.ClusterName = *dbInstances[0].DBClusterIdentifier
+		instance.SubnetGroup = dbInstances[0].DBSubnetGroup
+		return f.refreshCluster(ctx, instance)
 	}
 
 	instance.ARN = *dbInstances[0].DBInstanceArn
 	instance.ResourceID = *dbInstances[0].DbiResourceId
 	instance.Username = *dbInstances[0].MasterUsername
-	instance.DBName = *dbInstances[0].DBName
+	instance.DBName = aws.StringValue(dbInstances[0].DBName)
 	instance.SecGroups = dbInstances[0].VpcSecurityGroups
 	instance.SubnetGroup = dbInstances[0].DBSubnetGroup
 	instance.Engine = *dbInstances[0].Engine
+	instance.IAMAuth = aws.BoolValue(dbInstances[0].IAMDatabaseAuthenticationEnabled)
+	instance.Tags, err = f.listTags(instance.ARN)
+	if err != nil {
+		return err
+	}
 
-	go f.waitForInstance(instance, errChan, true)
-
-	return errChan
+	err = f.waitForInstance(ctx, instance, true)
+	if err != nil || !instance.IAMAuth {
+		return err
+	}
+	return f.waitForPasswordReset(ctx, instance, instance.Password)
 }
 
-func (f *CfRDSApi) waitForInstance(instance *DBInstance, errChan chan error, generateNewPassword bool) {
-	err := f.Svc.WaitUntilDBInstanceAvailable(&rds.DescribeDBInstancesInput{
+func (f *CfRDSApi) waitForInstance(ctx context.Context, instance *DBInstance, generateNewPassword bool) error {
+	err := f.Svc.WaitUntilDBInstanceAvailableWithContext(ctx, &rds.DescribeDBInstancesInput{
 		DBInstanceIdentifier: aws.String(instance.InstanceName),
-	})
+	}, f.waiterOptions(ctx)...)
 	if err != nil {
-		errChan <- err
-		return
+		return contextError(ctx, err)
 	}
 
-	describeDBInstancesResp, err := f.Svc.DescribeDBInstances(&rds.DescribeDBInstancesInput{
-		DBInstanceIdentifier: aws.String(instance.InstanceName),
-	})
+	current, err := f.waitForStatus(ctx, instance.InstanceName, "available")
 	if err != nil {
-		errChan <- err
-		return
+		return err
 	}
 
-	dbInstances := describeDBInstancesResp.DBInstances
-	if len(dbInstances) == 0 {
-		errChan <- fmt.Errorf("Could not find db instance %s", instance.InstanceName)
-		return
-	}
-
-	dbInstanceStatus := dbInstances[0].DBInstanceStatus
-	if *dbInstanceStatus == "available" {
-		if generateNewPassword {
-			instance.Password = GenerateRandomAlphanumericString()
-			_, err = f.Svc.ModifyDBInstance(&rds.ModifyDBInstanceInput{
-				DBInstanceIdentifier: aws.String(instance.InstanceName),
-				MasterUserPassword: aws.String(instance.Password),
-			})
-			if err != nil {
-				errChan <- err
-				return
-			}
+	if generateNewPassword {
+		instance.Password, err = GeneratePassword(instance.Engine)
+		if err != nil {
+			return err
+		}
+		_, err = f.Svc.ModifyDBInstance(&rds.ModifyDBInstanceInput{
+			DBInstanceIdentifier: aws.String(instance.InstanceName),
+			MasterUserPassword:   aws.String(instance.Password),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	instance.setURI(current.Address, current.Port)
+	return nil
+}
+
+// failedStatuses are the states an RDS instance does not leave on its own.
+var failedStatuses = map[string]bool{
+	"failed":                              true,
+	"incompatible-parameters":             true,
+	"incompatible-network":                true,
+	"storage-full":                        true,
+	"inaccessible-encryption-credentials": true,
+}
+
+// waitForStatus polls the instance until it reports the given status and
+// returns its state at that point. It gives up once the instance is in one
+// of the failedStatuses.
+func (f *CfRDSApi) waitForStatus(ctx context.Context, instanceName string, status string) (*DBInstance, error) {
+	for {
+		current, err := f.DescribeInstance(instanceName)
+		if err != nil {
+			return nil, err
+		}
+		reportInstanceProgress(ctx, current)
+
+		if current.Status == status {
+			return current, nil
+		}
+		if failedStatuses[current.Status] {
+			return nil, fmt.Errorf("Error: RDS instance %s is %s", instanceName, current.Status)
+		}
+
+		err = f.pause(ctx)
+		if err != nil {
+			return nil, err
 		}
-		dbAddr := dbInstances[0].Endpoint.Address
-		dbPort := dbInstances[0].Endpoint.Port
-		instance.DBURI = fmt.Sprintf("%s://%s:%s@%s:%d/%s", instance.Engine, instance.Username, instance.Password, *dbAddr, *dbPort, instance.DBName)
-		errChan <- nil
 	}
 }
 
-var GenerateRandomString = func() string {
-	rand.Seed(time.Now().UnixNano())
-	letterRunes := []rune("abcdefghijklmnopqrstuvwxyz")
-	b := make([]rune, 10)
-	for i := range b {
-		b[i] = letterRunes[rand.Intn(len(letterRunes))]
+// waiterOptions make the SDK's waiters poll every PollInterval, report the
+// instance's progress on every poll and leave it to the context to give up.
+func (f *CfRDSApi) waiterOptions(ctx context.Context) []request.WaiterOption {
+	return []request.WaiterOption{
+		request.WithWaiterDelay(request.ConstantWaiterDelay(f.PollInterval)),
+		request.WithWaiterMaxAttempts(math.MaxInt32),
+		request.WithWaiterRequestOptions(instanceProgress(ctx)),
 	}
+}
 
-	return string(b)
+// pause sleeps for PollInterval, returning early with the context's error
+// once ctx is done.
+func (f *CfRDSApi) pause(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(f.PollInterval):
+		return nil
+	}
 }
 
-var GenerateRandomAlphanumericString = func() string {
-	rand.Seed(time.Now().UnixNano())
-	letterRunes := []rune("abcdefghijklmnopqrstuvwxyz1234567890")
-	b := make([]rune, 10)
-	for i := range b {
-		b[i] = letterRunes[rand.Intn(len(letterRunes))]
+// contextError returns the error of a done context in place of err, so that
+// callers see context.Canceled or context.DeadlineExceeded rather than the
+// SDK's wrapped request errors.
+func contextError(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
 	}
+	return err
+}
 
-	return string(b)
-}
\ No newline at end of file
+// setURI fills in the connection details of the instance, building the URIs
+// the way its engine expects them.
+func (instance *DBInstance) setURI(address string, port int64) {
+	engine, err := LookupEngine(instance.Engine)
+	if err != nil {
+		engine = &Engine{Scheme: instance.Engine}
+	}
+
+	instance.Address = address
+	instance.Port = port
+	instance.DBURI = engine.URI(address, port, instance.Username, instance.Password, instance.DBName)
+	instance.JDBCURI = ""
+	if engine.JDBCURL != nil {
+		instance.JDBCURI = engine.JDBCURL(address, port, instance.Username, instance.Password, instance.DBName)
+	}
+	instance.ReadURI = ""
+	if instance.ReaderAddress != "" {
+		instance.ReadURI = engine.URI(instance.ReaderAddress, port, instance.Username, instance.Password, instance.DBName)
+	}
+}
+
+// ErrNoCredentials is returned in place of the SDK's NoCredentialProviders
+// error.
+var ErrNoCredentials = errors.New("No valid AWS credentials found. Please see this document for help configuring the AWS SDK: https://github.com/aws/aws-sdk-go#configuring-credentials")
+
+// credentialsError replaces the SDK's NoCredentialProviders error with a
+// message explaining how to configure AWS credentials.
+func credentialsError(err error) error {
+	if strings.Contains(err.Error(), "NoCredentialProviders") {
+		return ErrNoCredentials
+	}
+	return err
+}
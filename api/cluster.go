@@ -0,0 +1,273 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/rds"
+)
+
+// IsAurora reports whether the engine runs in DB clusters, whose member
+// instances share the storage, endpoints and credentials of the cluster.
+func IsAurora(engine string) bool {
+	return strings.HasPrefix(engine, "aurora")
+}
+
+// MemberName returns the name of the nth instance, counting from 0, of the
+// cluster. The first one carries the name of the cluster itself.
+func MemberName(clusterName string, n int) string {
+	if n == 0 {
+		return clusterName
+	}
+	return fmt.Sprintf("%s-%d", clusterName, n+1)
+}
+
+// createCluster creates the DB cluster of an Aurora instance along with
+// instance.Members member instances and waits until all of them are
+// available.
+func (f *CfRDSApi) createCluster(ctx context.Context, instance *DBInstance, dbName string, dbPassword string) error {
+	input := &rds.CreateDBClusterInput{
+		DBClusterIdentifier: aws.String(instance.InstanceName),
+		Engine:              aws.String(instance.Engine),
+		DBSubnetGroupName:   instance.SubnetGroup.DBSubnetGroupName,
+		MasterUsername:      aws.String(instance.Username),
+		MasterUserPassword:  aws.String(dbPassword),
+		Port:                aws.Int64(instance.Port),
+		Tags:                rdsTags(instance.Tags),
+	}
+	if dbName != "" {
+		input.DatabaseName = aws.String(dbName)
+	}
+	if instance.IAMAuth {
+		input.EnableIAMDatabaseAuthentication = aws.Bool(true)
+	}
+	for _, secGroup := range instance.SecGroups {
+		input.VpcSecurityGroupIds = append(input.VpcSecurityGroupIds, secGroup.VpcSecurityGroupId)
+	}
+
+	createDBClusterResp, err := f.Svc.CreateDBCluster(input)
+	if err != nil {
+		return credentialsError(err)
+	}
+
+	cluster := createDBClusterResp.DBCluster
+	if len(cluster.VpcSecurityGroups) == 0 {
+		return errors.New("Error: do not have any VPC security groups to associate with RDS instance")
+	}
+
+	instance.ClusterName = instance.InstanceName
+	instance.ARN = aws.StringValue(cluster.DBClusterArn)
+	instance.ResourceID = aws.StringValue(cluster.DbClusterResourceId)
+	instance.SecGroups = cluster.VpcSecurityGroups
+	instance.Password = dbPassword
+	instance.DBName = dbName
+
+	members := instance.Members
+	if members < 1 {
+		members = 1
+	}
+	for n := 0; n < members; n++ {
+		_, err = f.Svc.CreateDBInstance(&rds.CreateDBInstanceInput{
+			DBClusterIdentifier:     aws.String(instance.ClusterName),
+			DBInstanceIdentifier:    aws.String(MemberName(instance.ClusterName, n)),
+			DBInstanceClass:         aws.String(instance.InstanceClass),
+			Engine:                  aws.String(instance.Engine),
+			DBSubnetGroupName:       instance.SubnetGroup.DBSubnetGroupName,
+			AutoMinorVersionUpgrade: aws.Bool(instance.AutoMinorVersionUpgrade),
+			PubliclyAccessible:      aws.Bool(instance.PubliclyAccessible),
+			Tags:                    rdsTags(instance.Tags),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return f.waitForCluster(ctx, instance, false)
+}
+
+// refreshCluster reads the DB cluster of an Aurora instance back from AWS,
+// waits until it and its members are available and resets its master
+// password.
+func (f *CfRDSApi) refreshCluster(ctx context.Context, instance *DBInstance) error {
+	cluster, err := f.findCluster(instance.ClusterName)
+	if err != nil {
+		return err
+	}
+	if cluster == nil {
+		return fmt.Errorf("Could not find DB cluster %s", instance.ClusterName)
+	}
+
+	instance.ARN = aws.StringValue(cluster.DBClusterArn)
+	instance.ResourceID = aws.StringValue(cluster.DbClusterResourceId)
+	instance.Username = aws.StringValue(cluster.MasterUsername)
+	instance.DBName = aws.StringValue(cluster.DatabaseName)
+	instance.SecGroups = cluster.VpcSecurityGroups
+	instance.Engine = aws.StringValue(cluster.Engine)
+	instance.IAMAuth = aws.BoolValue(cluster.IAMDatabaseAuthenticationEnabled)
+	instance.Tags, err = f.listTags(instance.ARN)
+	if err != nil {
+		return err
+	}
+
+	return f.waitForCluster(ctx, instance, true)
+}
+
+func (f *CfRDSApi) waitForCluster(ctx context.Context, instance *DBInstance, generateNewPassword bool) error {
+	cluster, err := f.waitForClusterStatus(ctx, instance.ClusterName, "available")
+	if err != nil {
+		return err
+	}
+
+	for _, member := range cluster.DBClusterMembers {
+		err = f.Svc.WaitUntilDBInstanceAvailableWithContext(ctx, &rds.DescribeDBInstancesInput{
+			DBInstanceIdentifier: member.DBInstanceIdentifier,
+		}, f.waiterOptions(ctx)...)
+		if err != nil {
+			return contextError(ctx, err)
+		}
+	}
+
+	if generateNewPassword {
+		instance.Password, err = GeneratePassword(instance.Engine)
+		if err != nil {
+			return err
+		}
+		_, err = f.Svc.ModifyDBCluster(&rds.ModifyDBClusterInput{
+			DBClusterIdentifier: aws.String(instance.ClusterName),
+			MasterUserPassword:  aws.String(instance.Password),
+			ApplyImmediately:    aws.Bool(true),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	instance.ReaderAddress = aws.StringValue(cluster.ReaderEndpoint)
+	instance.setURI(aws.StringValue(cluster.Endpoint), aws.Int64Value(cluster.Port))
+	return nil
+}
+
+// waitForClusterStatus polls the cluster until it reports the given status
+// and returns its state at that point. Like waitForStatus it gives up once
+// the cluster is in one of the failedStatuses.
+func (f *CfRDSApi) waitForClusterStatus(ctx context.Context, clusterName string, status string) (*rds.DBCluster, error) {
+	for {
+		cluster, err := f.findCluster(clusterName)
+		if err != nil {
+			return nil, err
+		}
+		if cluster == nil {
+			return nil, fmt.Errorf("Could not find DB cluster %s", clusterName)
+		}
+		reportClusterProgress(ctx, cluster)
+
+		if aws.StringValue(cluster.Status) == status {
+			return cluster, nil
+		}
+		if failedStatuses[aws.StringValue(cluster.Status)] {
+			return nil, fmt.Errorf("Error: DB cluster %s is %s", clusterName, aws.StringValue(cluster.Status))
+		}
+
+		err = f.pause(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// deleteClusterMembers asks RDS to delete the members of the cluster, making
+// sure first that the final snapshot, if any, can be taken once they are gone.
+func (f *CfRDSApi) deleteClusterMembers(cluster *rds.DBCluster, finalSnapshotName string) error {
+	if finalSnapshotName != "" {
+		err := f.checkClusterSnapshotName(finalSnapshotName)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, member := range cluster.DBClusterMembers {
+		_, err := f.Svc.DeleteDBInstance(&rds.DeleteDBInstanceInput{
+			DBInstanceIdentifier: member.DBInstanceIdentifier,
+			SkipFinalSnapshot:    aws.Bool(true),
+		})
+		if err != nil {
+			return credentialsError(err)
+		}
+	}
+	return nil
+}
+
+// deleteCluster waits for the members of the cluster to be gone, then deletes
+// the cluster itself and waits for it to be gone. The final snapshot, if any,
+// is a DB cluster snapshot.
+func (f *CfRDSApi) deleteCluster(ctx context.Context, cluster *rds.DBCluster, finalSnapshotName string) error {
+	for _, member := range cluster.DBClusterMembers {
+		err := f.Svc.WaitUntilDBInstanceDeletedWithContext(ctx, &rds.DescribeDBInstancesInput{
+			DBInstanceIdentifier: member.DBInstanceIdentifier,
+		}, f.waiterOptions(ctx)...)
+		if err != nil {
+			return contextError(ctx, err)
+		}
+	}
+
+	input := &rds.DeleteDBClusterInput{
+		DBClusterIdentifier: cluster.DBClusterIdentifier,
+		SkipFinalSnapshot:   aws.Bool(finalSnapshotName == ""),
+	}
+	if finalSnapshotName != "" {
+		input.FinalDBSnapshotIdentifier = aws.String(finalSnapshotName)
+	}
+	_, err := f.Svc.DeleteDBCluster(input)
+	if err != nil {
+		return err
+	}
+
+	for {
+		current, err := f.findCluster(aws.StringValue(cluster.DBClusterIdentifier))
+		if err != nil {
+			return err
+		}
+		if current == nil {
+			return nil
+		}
+		reportClusterProgress(ctx, current)
+
+		err = f.pause(ctx)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// findCluster returns the DB cluster, or nil if there is none of that name.
+func (f *CfRDSApi) findCluster(clusterName string) (*rds.DBCluster, error) {
+	describeDBClustersResp, err := f.Svc.DescribeDBClusters(&rds.DescribeDBClustersInput{
+		DBClusterIdentifier: aws.String(clusterName),
+	})
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == rds.ErrCodeDBClusterNotFoundFault {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, credentialsError(err)
+	}
+
+	if len(describeDBClustersResp.DBClusters) == 0 {
+		return nil, nil
+	}
+	return describeDBClustersResp.DBClusters[0], nil
+}
+
+// reportClusterProgress reports the status of the cluster. RDS reports the
+// percent progress of clusters as a string.
+func reportClusterProgress(ctx context.Context, cluster *rds.DBCluster) {
+	percent, err := strconv.ParseInt(aws.StringValue(cluster.PercentProgress), 10, 64)
+	if err != nil {
+		percent = -1
+	}
+	ReportProgress(ctx, "DB cluster", aws.StringValue(cluster.DBClusterIdentifier), aws.StringValue(cluster.Status), percent)
+}
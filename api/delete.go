@@ -0,0 +1,75 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/rds"
+)
+
+// DeleteInstance asks RDS to delete the RDS instance; WaitForDeletion waits for
+// it to be gone. When finalSnapshotName is empty the instance is deleted
+// without a final snapshot. An Aurora DB cluster of the same name is deleted
+// along with all its members.
+func (f *CfRDSApi) DeleteInstance(instance *DBInstance, finalSnapshotName string) error {
+	cluster, err := f.findCluster(instance.InstanceName)
+	if err != nil {
+		return err
+	}
+	if cluster != nil {
+		return f.deleteClusterMembers(cluster, finalSnapshotName)
+	}
+
+	input := &rds.DeleteDBInstanceInput{
+		DBInstanceIdentifier: aws.String(instance.InstanceName),
+		SkipFinalSnapshot:    aws.Bool(finalSnapshotName == ""),
+	}
+	if finalSnapshotName != "" {
+		input.FinalDBSnapshotIdentifier = aws.String(finalSnapshotName)
+	}
+
+	_, err = f.Svc.DeleteDBInstance(input)
+	if err != nil {
+		return credentialsError(err)
+	}
+	return nil
+}
+
+// WaitForDeletion waits for the RDS instance deleted by DeleteInstance to be
+// gone. An Aurora DB cluster is deleted once its members are, taking the final
+// snapshot, if any, as a DB cluster snapshot.
+func (f *CfRDSApi) WaitForDeletion(ctx context.Context, instance *DBInstance, finalSnapshotName string) error {
+	cluster, err := f.findCluster(instance.InstanceName)
+	if err != nil {
+		return err
+	}
+	if cluster != nil {
+		return f.deleteCluster(ctx, cluster, finalSnapshotName)
+	}
+
+	err = f.Svc.WaitUntilDBInstanceDeletedWithContext(ctx, &rds.DescribeDBInstancesInput{
+		DBInstanceIdentifier: aws.String(instance.InstanceName),
+	}, f.waiterOptions(ctx)...)
+	if err != nil {
+		return contextError(ctx, err)
+	}
+	return nil
+}
+
+// checkClusterSnapshotName returns an error if a DB cluster snapshot of the
+// name exists already, as the cluster could then not be deleted once its
+// members are gone.
+func (f *CfRDSApi) checkClusterSnapshotName(snapshotName string) error {
+	_, err := f.Svc.DescribeDBClusterSnapshots(&rds.DescribeDBClusterSnapshotsInput{
+		DBClusterSnapshotIdentifier: aws.String(snapshotName),
+	})
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == rds.ErrCodeDBClusterSnapshotNotFoundFault {
+		return nil
+	}
+	if err != nil {
+		return credentialsError(err)
+	}
+	return fmt.Errorf("Error: DB cluster snapshot %s already exists", snapshotName)
+}
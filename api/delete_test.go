@@ -0,0 +1,97 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/rds"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/seattle-beach/cf-cli-rds-plugin/api"
+	"github.com/seattle-beach/cf-cli-rds-plugin/api/fakes"
+)
+
+var _ = Describe("DeleteInstance", func() {
+	var fakeRDSSvc *fakes.FakeRDSService
+	var cfRDSApi *api.CfRDSApi
+	var instance *api.DBInstance
+
+	BeforeEach(func() {
+		fakeRDSSvc = &fakes.FakeRDSService{}
+		cfRDSApi = &api.CfRDSApi{
+			Svc: fakeRDSSvc,
+		}
+		instance = &api.DBInstance{
+			InstanceName: "test-instance",
+		}
+
+		fakeRDSSvc.DescribeDBClustersReturns(nil, awserr.New(rds.ErrCodeDBClusterNotFoundFault, "DBCluster test-instance not found.", nil))
+		fakeRDSSvc.DeleteDBInstanceReturns(&rds.DeleteDBInstanceOutput{}, nil)
+		fakeRDSSvc.WaitUntilDBInstanceDeletedWithContextReturns(nil)
+	})
+
+	It("deletes the RDS instance without a final snapshot", func() {
+		err := cfRDSApi.DeleteInstance(instance, "")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeRDSSvc.DeleteDBInstanceCallCount()).To(Equal(1))
+		Expect(fakeRDSSvc.DeleteDBInstanceArgsForCall(0)).To(Equal(&rds.DeleteDBInstanceInput{
+			DBInstanceIdentifier: aws.String("test-instance"),
+			SkipFinalSnapshot:    aws.Bool(true),
+		}))
+	})
+
+	It("takes a final snapshot when a snapshot name is given", func() {
+		err := cfRDSApi.DeleteInstance(instance, "final-snap")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeRDSSvc.DeleteDBInstanceArgsForCall(0)).To(Equal(&rds.DeleteDBInstanceInput{
+			DBInstanceIdentifier:      aws.String("test-instance"),
+			SkipFinalSnapshot:         aws.Bool(false),
+			FinalDBSnapshotIdentifier: aws.String("final-snap"),
+		}))
+	})
+
+	It("does not wait for the RDS instance to be gone", func() {
+		err := cfRDSApi.DeleteInstance(instance, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fakeRDSSvc.WaitUntilDBInstanceDeletedWithContextCallCount()).To(Equal(0))
+	})
+
+	It("waits until the RDS instance is deleted", func() {
+		err := cfRDSApi.WaitForDeletion(context.Background(), instance, "")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeRDSSvc.WaitUntilDBInstanceDeletedWithContextCallCount()).To(Equal(1))
+		_, input, _ := fakeRDSSvc.WaitUntilDBInstanceDeletedWithContextArgsForCall(0)
+		Expect(input).To(Equal(&rds.DescribeDBInstancesInput{
+			DBInstanceIdentifier: aws.String("test-instance"),
+		}))
+	})
+
+	Context("error cases", func() {
+		Context("when no AWS credentials are provided", func() {
+			BeforeEach(func() {
+				fakeRDSSvc.DeleteDBInstanceReturns(nil, errors.New("NoCredentialProviders"))
+			})
+
+			It("should return helpful error", func() {
+				err := cfRDSApi.DeleteInstance(instance, "")
+				Expect(err).To(MatchError("No valid AWS credentials found. Please see this document for help configuring the AWS SDK: https://github.com/aws/aws-sdk-go#configuring-credentials"))
+			})
+		})
+
+		Context("when waiting for deletion fails", func() {
+			BeforeEach(func() {
+				fakeRDSSvc.WaitUntilDBInstanceDeletedWithContextReturns(errors.New("waiter failed"))
+			})
+
+			It("returns the error", func() {
+				err := cfRDSApi.WaitForDeletion(context.Background(), instance, "")
+				Expect(err).To(MatchError("waiter failed"))
+			})
+		})
+	})
+})
@@ -0,0 +1,64 @@
+package api
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+)
+
+func newDBInstance(dbInstance *rds.DBInstance) *DBInstance {
+	instance := &DBInstance{
+		ARN:           aws.StringValue(dbInstance.DBInstanceArn),
+		InstanceName:  aws.StringValue(dbInstance.DBInstanceIdentifier),
+		ResourceID:    aws.StringValue(dbInstance.DbiResourceId),
+		Username:      aws.StringValue(dbInstance.MasterUsername),
+		DBName:        aws.StringValue(dbInstance.DBName),
+		SecGroups:     dbInstance.VpcSecurityGroups,
+		SubnetGroup:   dbInstance.DBSubnetGroup,
+		Engine:        aws.StringValue(dbInstance.Engine),
+		InstanceClass: aws.StringValue(dbInstance.DBInstanceClass),
+		Storage:       aws.Int64Value(dbInstance.AllocatedStorage),
+		IOPS:          aws.Int64Value(dbInstance.Iops),
+		AZ:            aws.StringValue(dbInstance.AvailabilityZone),
+		Status:        aws.StringValue(dbInstance.DBInstanceStatus),
+		ClusterName:   aws.StringValue(dbInstance.DBClusterIdentifier),
+		ReplicaSource: aws.StringValue(dbInstance.ReadReplicaSourceDBInstanceIdentifier),
+
+		EngineVersion:           aws.StringValue(dbInstance.EngineVersion),
+		MultiAZ:                 aws.BoolValue(dbInstance.MultiAZ),
+		PubliclyAccessible:      aws.BoolValue(dbInstance.PubliclyAccessible),
+		AutoMinorVersionUpgrade: aws.BoolValue(dbInstance.AutoMinorVersionUpgrade),
+		BackupRetention:         aws.Int64Value(dbInstance.BackupRetentionPeriod),
+		MaintenanceWindow:       aws.StringValue(dbInstance.PreferredMaintenanceWindow),
+		PendingModifications:    dbInstance.PendingModifiedValues,
+	}
+
+	if len(dbInstance.DBParameterGroups) > 0 {
+		instance.ParameterGroup = dbInstance.DBParameterGroups[0]
+	}
+
+	if dbInstance.Endpoint != nil {
+		instance.Address = aws.StringValue(dbInstance.Endpoint.Address)
+		instance.Port = aws.Int64Value(dbInstance.Endpoint.Port)
+	}
+
+	return instance
+}
+
+// DescribeInstance returns the current state of a single RDS instance.
+func (f *CfRDSApi) DescribeInstance(instanceName string) (*DBInstance, error) {
+	describeDBInstancesResp, err := f.Svc.DescribeDBInstances(&rds.DescribeDBInstancesInput{
+		DBInstanceIdentifier: aws.String(instanceName),
+	})
+	if err != nil {
+		return nil, credentialsError(err)
+	}
+
+	dbInstances := describeDBInstancesResp.DBInstances
+	if len(dbInstances) == 0 {
+		return nil, fmt.Errorf("Could not find db instance %s", instanceName)
+	}
+
+	return newDBInstance(dbInstances[0]), nil
+}
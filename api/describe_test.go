@@ -0,0 +1,78 @@
+package api_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/seattle-beach/cf-cli-rds-plugin/api"
+	"github.com/seattle-beach/cf-cli-rds-plugin/api/fakes"
+)
+
+var _ = Describe("DescribeInstance", func() {
+	var fakeRDSSvc *fakes.FakeRDSService
+	var cfRDSApi *api.CfRDSApi
+
+	BeforeEach(func() {
+		fakeRDSSvc = &fakes.FakeRDSService{}
+		cfRDSApi = &api.CfRDSApi{
+			Svc: fakeRDSSvc,
+		}
+
+		fakeRDSSvc.DescribeDBInstancesReturns(&rds.DescribeDBInstancesOutput{
+			DBInstances: []*rds.DBInstance{{
+				DBInstanceIdentifier:       aws.String("test-instance"),
+				DBInstanceArn:              aws.String("arn:aws:rds:us-east-1:10101010:db:name"),
+				DbiResourceId:              aws.String("resourceid"),
+				DBInstanceStatus:           aws.String("modifying"),
+				DBInstanceClass:            aws.String("db.t2.micro"),
+				Engine:                     aws.String("postgres"),
+				EngineVersion:              aws.String("9.6.5"),
+				AllocatedStorage:           aws.Int64(20),
+				MultiAZ:                    aws.Bool(true),
+				PubliclyAccessible:         aws.Bool(true),
+				AutoMinorVersionUpgrade:    aws.Bool(false),
+				BackupRetentionPeriod:      aws.Int64(7),
+				PreferredMaintenanceWindow: aws.String("sun:05:00-sun:06:00"),
+				PendingModifiedValues: &rds.PendingModifiedValues{
+					DBInstanceClass: aws.String("db.m4.large"),
+				},
+			}},
+		}, nil)
+	})
+
+	It("describes the named instance", func() {
+		instance, err := cfRDSApi.DescribeInstance("test-instance")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeRDSSvc.DescribeDBInstancesArgsForCall(0)).To(Equal(&rds.DescribeDBInstancesInput{
+			DBInstanceIdentifier: aws.String("test-instance"),
+		}))
+		Expect(instance.InstanceName).To(Equal("test-instance"))
+		Expect(instance.ARN).To(Equal("arn:aws:rds:us-east-1:10101010:db:name"))
+		Expect(instance.Status).To(Equal("modifying"))
+		Expect(instance.EngineVersion).To(Equal("9.6.5"))
+		Expect(instance.MultiAZ).To(BeTrue())
+		Expect(instance.PubliclyAccessible).To(BeTrue())
+		Expect(instance.AutoMinorVersionUpgrade).To(BeFalse())
+		Expect(instance.BackupRetention).To(Equal(int64(7)))
+		Expect(instance.MaintenanceWindow).To(Equal("sun:05:00-sun:06:00"))
+		Expect(instance.PendingModifications.DBInstanceClass).To(Equal(aws.String("db.m4.large")))
+	})
+
+	Context("error cases", func() {
+		It("returns an error when the instance does not exist", func() {
+			fakeRDSSvc.DescribeDBInstancesReturns(&rds.DescribeDBInstancesOutput{}, nil)
+			_, err := cfRDSApi.DescribeInstance("test-instance")
+			Expect(err).To(MatchError("Could not find db instance test-instance"))
+		})
+
+		It("returns a helpful error when no AWS credentials are provided", func() {
+			fakeRDSSvc.DescribeDBInstancesReturns(nil, errors.New("NoCredentialProviders"))
+			_, err := cfRDSApi.DescribeInstance("test-instance")
+			Expect(err).To(MatchError("No valid AWS credentials found. Please see this document for help configuring the AWS SDK: https://github.com/aws/aws-sdk-go#configuring-credentials"))
+		})
+	})
+})
@@ -0,0 +1,232 @@
+package api
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// Engine describes how to provision and connect to one family of RDS
+// engines.
+type Engine struct {
+	// Scheme is the scheme of the connection URI handed to apps.
+	Scheme string
+	// JDBCURL builds the JDBC URL for the connection; credentials passed in
+	// are not escaped yet.
+	JDBCURL           func(host string, port int64, username, password, dbName string) string
+	DefaultPort       int64
+	MaxUsernameLength int
+	PasswordRules     StringRules
+	// DBNameRules is nil for engines that do not accept a database name at
+	// creation time.
+	DBNameRules *StringRules
+	// IAMAuth is nil for engines without IAM database authentication.
+	IAMAuth *IAMAuth
+}
+
+// IAMAuth describes how to set up the DB user apps log in as with IAM auth
+// tokens.
+type IAMAuth struct {
+	// Driver is the database/sql driver the plugin connects with as the
+	// master user.
+	Driver string
+	// DataSourceName builds the data source name of the driver, which
+	// connects over TLS, verifying the server certificate against the CA
+	// certificates in caFile.
+	DataSourceName func(host string, port int64, username, password, dbName string, caFile string) (string, error)
+	// CreateUser returns the statements creating the user and granting it
+	// the database. They succeed if the user exists already, so that the
+	// set-up can be run again.
+	CreateUser func(username, dbName string) []string
+}
+
+var postgresEngine = &Engine{
+	Scheme: "postgres",
+	JDBCURL: func(host string, port int64, username, password, dbName string) string {
+		return fmt.Sprintf("jdbc:postgresql://%s:%d/%s?user=%s&password=%s", host, port, url.PathEscape(dbName), url.QueryEscape(username), url.QueryEscape(password))
+	},
+	DefaultPort:       5432,
+	MaxUsernameLength: 63,
+	PasswordRules: StringRules{
+		Length:  32,
+		Classes: []string{LowerCase, UpperCase, Digits, PasswordSymbols},
+	},
+	DBNameRules: &StringRules{
+		Length:  10,
+		Classes: []string{LowerCase},
+	},
+	IAMAuth: &IAMAuth{
+		Driver: "postgres",
+		DataSourceName: func(host string, port int64, username, password, dbName string, caFile string) (string, error) {
+			uri := url.URL{
+				Scheme: "postgres",
+				User:   url.UserPassword(username, password),
+				Host:   fmt.Sprintf("%s:%d", host, port),
+				Path:   "/" + dbName,
+				RawQuery: url.Values{
+					"sslmode":     {"verify-full"},
+					"sslrootcert": {caFile},
+				}.Encode(),
+			}
+			return uri.String(), nil
+		},
+		CreateUser: func(username, dbName string) []string {
+			return []string{
+				// PostgreSQL has no CREATE USER IF NOT EXISTS.
+				fmt.Sprintf(`DO $$BEGIN CREATE USER "%s"; EXCEPTION WHEN duplicate_object THEN NULL; END$$`, username),
+				fmt.Sprintf(`GRANT %s TO "%s"`, IAMRole, username),
+				fmt.Sprintf(`GRANT ALL PRIVILEGES ON DATABASE "%s" TO "%s"`, dbName, username),
+			}
+		},
+	},
+}
+
+var mysqlEngine = &Engine{
+	Scheme: "mysql",
+	JDBCURL: func(host string, port int64, username, password, dbName string) string {
+		return fmt.Sprintf("jdbc:mysql://%s:%d/%s?user=%s&password=%s", host, port, url.PathEscape(dbName), url.QueryEscape(username), url.QueryEscape(password))
+	},
+	DefaultPort:       3306,
+	MaxUsernameLength: 16,
+	// MySQL limits master passwords to 41 characters.
+	PasswordRules: StringRules{
+		Length:  41,
+		Classes: []string{LowerCase, UpperCase, Digits, PasswordSymbols},
+	},
+	DBNameRules: &StringRules{
+		Length:  10,
+		Classes: []string{LowerCase},
+	},
+	IAMAuth: &IAMAuth{
+		Driver: "mysql",
+		DataSourceName: func(host string, port int64, username, password, dbName string, caFile string) (string, error) {
+			rootCAs, err := readCertPool(caFile)
+			if err != nil {
+				return "", err
+			}
+			// The driver looks TLS configs up by name.
+			tlsConfigName := "rds-" + host
+			err = mysql.RegisterTLSConfig(tlsConfigName, &tls.Config{
+				RootCAs:    rootCAs,
+				ServerName: host,
+			})
+			if err != nil {
+				return "", err
+			}
+
+			config := mysql.NewConfig()
+			config.User = username
+			config.Passwd = password
+			config.Net = "tcp"
+			config.Addr = fmt.Sprintf("%s:%d", host, port)
+			config.DBName = dbName
+			config.TLSConfig = tlsConfigName
+			return config.FormatDSN(), nil
+		},
+		CreateUser: func(username, dbName string) []string {
+			return []string{
+				fmt.Sprintf("CREATE USER IF NOT EXISTS '%s'@'%%' IDENTIFIED WITH AWSAuthenticationPlugin AS 'RDS'", username),
+				fmt.Sprintf("GRANT ALL PRIVILEGES ON `%s`.* TO '%s'@'%%'", dbName, username),
+			}
+		},
+	},
+}
+
+var oracleEngine = &Engine{
+	Scheme: "oracle",
+	JDBCURL: func(host string, port int64, username, password, dbName string) string {
+		return fmt.Sprintf("jdbc:oracle:thin:%s/%s@//%s:%d/%s", url.QueryEscape(username), url.QueryEscape(password), host, port, url.PathEscape(dbName))
+	},
+	DefaultPort:       1521,
+	MaxUsernameLength: 30,
+	// Oracle limits passwords to 30 characters, they must start with a
+	// letter and only "_" is safe to use unquoted.
+	PasswordRules: StringRules{
+		Length:     30,
+		Classes:    []string{LowerCase, UpperCase, Digits, "_"},
+		FirstClass: LowerCase + UpperCase,
+	},
+	// Oracle database names (SIDs) are at most 8 characters long.
+	DBNameRules: &StringRules{
+		Length:  8,
+		Classes: []string{LowerCase},
+	},
+}
+
+var sqlServerEngine = &Engine{
+	Scheme: "sqlserver",
+	JDBCURL: func(host string, port int64, username, password, dbName string) string {
+		jdbcURL := fmt.Sprintf("jdbc:sqlserver://%s:%d;user=%s;password=%s", host, port, sqlServerEscape(username), sqlServerEscape(password))
+		if dbName != "" {
+			jdbcURL += ";databaseName=" + sqlServerEscape(dbName)
+		}
+		return jdbcURL
+	},
+	DefaultPort:       1433,
+	MaxUsernameLength: 128,
+	PasswordRules: StringRules{
+		Length:  32,
+		Classes: []string{LowerCase, UpperCase, Digits, PasswordSymbols},
+	},
+}
+
+// Engines maps RDS engine names to their descriptors.
+var Engines = map[string]*Engine{
+	"postgres":          postgresEngine,
+	"aurora-postgresql": postgresEngine,
+	"mysql":             mysqlEngine,
+	"mariadb":           mysqlEngine,
+	"aurora":            mysqlEngine,
+	"aurora-mysql":      mysqlEngine,
+	"oracle-se":         oracleEngine,
+	"oracle-se1":        oracleEngine,
+	"oracle-se2":        oracleEngine,
+	"oracle-ee":         oracleEngine,
+	"sqlserver-ee":      sqlServerEngine,
+	"sqlserver-se":      sqlServerEngine,
+	"sqlserver-ex":      sqlServerEngine,
+	"sqlserver-web":     sqlServerEngine,
+}
+
+func LookupEngine(name string) (*Engine, error) {
+	engine, ok := Engines[name]
+	if !ok {
+		return nil, fmt.Errorf("Error: unsupported RDS engine %s", name)
+	}
+	return engine, nil
+}
+
+var usernamePattern = regexp.MustCompile("^[a-zA-Z][a-zA-Z0-9]*$")
+
+// ValidateUsername checks a master username against the engine's rules.
+func (e *Engine) ValidateUsername(username string) error {
+	if !usernamePattern.MatchString(username) || len(username) > e.MaxUsernameLength {
+		return fmt.Errorf("Error: the master username must start with a letter, contain only letters and digits and be at most %d characters long", e.MaxUsernameLength)
+	}
+	return nil
+}
+
+// URI builds the connection URI handed to apps, escaping every credential.
+func (e *Engine) URI(host string, port int64, username, password, dbName string) string {
+	uri := url.URL{
+		Scheme: e.Scheme,
+		User:   url.UserPassword(username, password),
+		Host:   fmt.Sprintf("%s:%d", host, port),
+	}
+	if dbName != "" {
+		uri.Path = "/" + dbName
+	}
+	return uri.String()
+}
+
+// sqlServerEscape wraps values containing JDBC property separators in braces.
+func sqlServerEscape(value string) string {
+	if strings.ContainsAny(value, ";={}") {
+		return "{" + strings.Replace(value, "}", "}}", -1) + "}"
+	}
+	return value
+}
@@ -4,22 +4,36 @@ package fakes
 import (
 	"sync"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/seattle-beach/cf-cli-rds-plugin/api"
 )
 
 type FakeRDSService struct {
-	DescribeDBSubnetGroupsStub        func(input *rds.DescribeDBSubnetGroupsInput) (*rds.DescribeDBSubnetGroupsOutput, error)
-	describeDBSubnetGroupsMutex       sync.RWMutex
-	describeDBSubnetGroupsArgsForCall []struct {
+	DescribeDBSubnetGroupsPagesStub        func(input *rds.DescribeDBSubnetGroupsInput, fn func(*rds.DescribeDBSubnetGroupsOutput, bool) bool) error
+	describeDBSubnetGroupsPagesMutex       sync.RWMutex
+	describeDBSubnetGroupsPagesArgsForCall []struct {
 		input *rds.DescribeDBSubnetGroupsInput
+		fn    func(*rds.DescribeDBSubnetGroupsOutput, bool) bool
 	}
-	describeDBSubnetGroupsReturns struct {
-		result1 *rds.DescribeDBSubnetGroupsOutput
+	describeDBSubnetGroupsPagesReturns struct {
+		result1 error
+	}
+	describeDBSubnetGroupsPagesReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ListTagsForResourceStub        func(input *rds.ListTagsForResourceInput) (*rds.ListTagsForResourceOutput, error)
+	listTagsForResourceMutex       sync.RWMutex
+	listTagsForResourceArgsForCall []struct {
+		input *rds.ListTagsForResourceInput
+	}
+	listTagsForResourceReturns struct {
+		result1 *rds.ListTagsForResourceOutput
 		result2 error
 	}
-	describeDBSubnetGroupsReturnsOnCall map[int]struct {
-		result1 *rds.DescribeDBSubnetGroupsOutput
+	listTagsForResourceReturnsOnCall map[int]struct {
+		result1 *rds.ListTagsForResourceOutput
 		result2 error
 	}
 	CreateDBInstanceStub        func(input *rds.CreateDBInstanceInput) (*rds.CreateDBInstanceOutput, error)
@@ -61,68 +75,404 @@ type FakeRDSService struct {
 		result1 *rds.ModifyDBInstanceOutput
 		result2 error
 	}
-	WaitUntilDBInstanceAvailableStub        func(input *rds.DescribeDBInstancesInput) error
-	waitUntilDBInstanceAvailableMutex       sync.RWMutex
-	waitUntilDBInstanceAvailableArgsForCall []struct {
+	WaitUntilDBInstanceAvailableWithContextStub        func(ctx aws.Context, input *rds.DescribeDBInstancesInput, opts ...request.WaiterOption) error
+	waitUntilDBInstanceAvailableWithContextMutex       sync.RWMutex
+	waitUntilDBInstanceAvailableWithContextArgsForCall []struct {
+		ctx   aws.Context
+		input *rds.DescribeDBInstancesInput
+		opts  []request.WaiterOption
+	}
+	waitUntilDBInstanceAvailableWithContextReturns struct {
+		result1 error
+	}
+	waitUntilDBInstanceAvailableWithContextReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteDBInstanceStub        func(input *rds.DeleteDBInstanceInput) (*rds.DeleteDBInstanceOutput, error)
+	deleteDBInstanceMutex       sync.RWMutex
+	deleteDBInstanceArgsForCall []struct {
+		input *rds.DeleteDBInstanceInput
+	}
+	deleteDBInstanceReturns struct {
+		result1 *rds.DeleteDBInstanceOutput
+		result2 error
+	}
+	deleteDBInstanceReturnsOnCall map[int]struct {
+		result1 *rds.DeleteDBInstanceOutput
+		result2 error
+	}
+	WaitUntilDBInstanceDeletedWithContextStub        func(ctx aws.Context, input *rds.DescribeDBInstancesInput, opts ...request.WaiterOption) error
+	waitUntilDBInstanceDeletedWithContextMutex       sync.RWMutex
+	waitUntilDBInstanceDeletedWithContextArgsForCall []struct {
+		ctx   aws.Context
 		input *rds.DescribeDBInstancesInput
+		opts  []request.WaiterOption
+	}
+	waitUntilDBInstanceDeletedWithContextReturns struct {
+		result1 error
+	}
+	waitUntilDBInstanceDeletedWithContextReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CreateDBSnapshotStub        func(input *rds.CreateDBSnapshotInput) (*rds.CreateDBSnapshotOutput, error)
+	createDBSnapshotMutex       sync.RWMutex
+	createDBSnapshotArgsForCall []struct {
+		input *rds.CreateDBSnapshotInput
+	}
+	createDBSnapshotReturns struct {
+		result1 *rds.CreateDBSnapshotOutput
+		result2 error
+	}
+	createDBSnapshotReturnsOnCall map[int]struct {
+		result1 *rds.CreateDBSnapshotOutput
+		result2 error
+	}
+	DescribeDBSnapshotsStub        func(input *rds.DescribeDBSnapshotsInput) (*rds.DescribeDBSnapshotsOutput, error)
+	describeDBSnapshotsMutex       sync.RWMutex
+	describeDBSnapshotsArgsForCall []struct {
+		input *rds.DescribeDBSnapshotsInput
+	}
+	describeDBSnapshotsReturns struct {
+		result1 *rds.DescribeDBSnapshotsOutput
+		result2 error
+	}
+	describeDBSnapshotsReturnsOnCall map[int]struct {
+		result1 *rds.DescribeDBSnapshotsOutput
+		result2 error
+	}
+	DeleteDBSnapshotStub        func(input *rds.DeleteDBSnapshotInput) (*rds.DeleteDBSnapshotOutput, error)
+	deleteDBSnapshotMutex       sync.RWMutex
+	deleteDBSnapshotArgsForCall []struct {
+		input *rds.DeleteDBSnapshotInput
+	}
+	deleteDBSnapshotReturns struct {
+		result1 *rds.DeleteDBSnapshotOutput
+		result2 error
+	}
+	deleteDBSnapshotReturnsOnCall map[int]struct {
+		result1 *rds.DeleteDBSnapshotOutput
+		result2 error
+	}
+	RestoreDBInstanceFromDBSnapshotStub        func(input *rds.RestoreDBInstanceFromDBSnapshotInput) (*rds.RestoreDBInstanceFromDBSnapshotOutput, error)
+	restoreDBInstanceFromDBSnapshotMutex       sync.RWMutex
+	restoreDBInstanceFromDBSnapshotArgsForCall []struct {
+		input *rds.RestoreDBInstanceFromDBSnapshotInput
+	}
+	restoreDBInstanceFromDBSnapshotReturns struct {
+		result1 *rds.RestoreDBInstanceFromDBSnapshotOutput
+		result2 error
+	}
+	restoreDBInstanceFromDBSnapshotReturnsOnCall map[int]struct {
+		result1 *rds.RestoreDBInstanceFromDBSnapshotOutput
+		result2 error
+	}
+	RestoreDBInstanceToPointInTimeStub        func(input *rds.RestoreDBInstanceToPointInTimeInput) (*rds.RestoreDBInstanceToPointInTimeOutput, error)
+	restoreDBInstanceToPointInTimeMutex       sync.RWMutex
+	restoreDBInstanceToPointInTimeArgsForCall []struct {
+		input *rds.RestoreDBInstanceToPointInTimeInput
+	}
+	restoreDBInstanceToPointInTimeReturns struct {
+		result1 *rds.RestoreDBInstanceToPointInTimeOutput
+		result2 error
+	}
+	restoreDBInstanceToPointInTimeReturnsOnCall map[int]struct {
+		result1 *rds.RestoreDBInstanceToPointInTimeOutput
+		result2 error
+	}
+	StopDBInstanceStub        func(input *rds.StopDBInstanceInput) (*rds.StopDBInstanceOutput, error)
+	stopDBInstanceMutex       sync.RWMutex
+	stopDBInstanceArgsForCall []struct {
+		input *rds.StopDBInstanceInput
+	}
+	stopDBInstanceReturns struct {
+		result1 *rds.StopDBInstanceOutput
+		result2 error
+	}
+	stopDBInstanceReturnsOnCall map[int]struct {
+		result1 *rds.StopDBInstanceOutput
+		result2 error
+	}
+	StartDBInstanceStub        func(input *rds.StartDBInstanceInput) (*rds.StartDBInstanceOutput, error)
+	startDBInstanceMutex       sync.RWMutex
+	startDBInstanceArgsForCall []struct {
+		input *rds.StartDBInstanceInput
+	}
+	startDBInstanceReturns struct {
+		result1 *rds.StartDBInstanceOutput
+		result2 error
+	}
+	startDBInstanceReturnsOnCall map[int]struct {
+		result1 *rds.StartDBInstanceOutput
+		result2 error
+	}
+	RebootDBInstanceStub        func(input *rds.RebootDBInstanceInput) (*rds.RebootDBInstanceOutput, error)
+	rebootDBInstanceMutex       sync.RWMutex
+	rebootDBInstanceArgsForCall []struct {
+		input *rds.RebootDBInstanceInput
+	}
+	rebootDBInstanceReturns struct {
+		result1 *rds.RebootDBInstanceOutput
+		result2 error
+	}
+	rebootDBInstanceReturnsOnCall map[int]struct {
+		result1 *rds.RebootDBInstanceOutput
+		result2 error
+	}
+	CreateDBClusterStub        func(input *rds.CreateDBClusterInput) (*rds.CreateDBClusterOutput, error)
+	createDBClusterMutex       sync.RWMutex
+	createDBClusterArgsForCall []struct {
+		input *rds.CreateDBClusterInput
+	}
+	createDBClusterReturns struct {
+		result1 *rds.CreateDBClusterOutput
+		result2 error
+	}
+	createDBClusterReturnsOnCall map[int]struct {
+		result1 *rds.CreateDBClusterOutput
+		result2 error
+	}
+	DescribeDBClustersStub        func(input *rds.DescribeDBClustersInput) (*rds.DescribeDBClustersOutput, error)
+	describeDBClustersMutex       sync.RWMutex
+	describeDBClustersArgsForCall []struct {
+		input *rds.DescribeDBClustersInput
+	}
+	describeDBClustersReturns struct {
+		result1 *rds.DescribeDBClustersOutput
+		result2 error
+	}
+	describeDBClustersReturnsOnCall map[int]struct {
+		result1 *rds.DescribeDBClustersOutput
+		result2 error
+	}
+	ModifyDBClusterStub        func(input *rds.ModifyDBClusterInput) (*rds.ModifyDBClusterOutput, error)
+	modifyDBClusterMutex       sync.RWMutex
+	modifyDBClusterArgsForCall []struct {
+		input *rds.ModifyDBClusterInput
+	}
+	modifyDBClusterReturns struct {
+		result1 *rds.ModifyDBClusterOutput
+		result2 error
+	}
+	modifyDBClusterReturnsOnCall map[int]struct {
+		result1 *rds.ModifyDBClusterOutput
+		result2 error
+	}
+	DeleteDBClusterStub        func(input *rds.DeleteDBClusterInput) (*rds.DeleteDBClusterOutput, error)
+	deleteDBClusterMutex       sync.RWMutex
+	deleteDBClusterArgsForCall []struct {
+		input *rds.DeleteDBClusterInput
+	}
+	deleteDBClusterReturns struct {
+		result1 *rds.DeleteDBClusterOutput
+		result2 error
+	}
+	deleteDBClusterReturnsOnCall map[int]struct {
+		result1 *rds.DeleteDBClusterOutput
+		result2 error
+	}
+	DescribeDBClusterSnapshotsStub        func(input *rds.DescribeDBClusterSnapshotsInput) (*rds.DescribeDBClusterSnapshotsOutput, error)
+	describeDBClusterSnapshotsMutex       sync.RWMutex
+	describeDBClusterSnapshotsArgsForCall []struct {
+		input *rds.DescribeDBClusterSnapshotsInput
+	}
+	describeDBClusterSnapshotsReturns struct {
+		result1 *rds.DescribeDBClusterSnapshotsOutput
+		result2 error
+	}
+	describeDBClusterSnapshotsReturnsOnCall map[int]struct {
+		result1 *rds.DescribeDBClusterSnapshotsOutput
+		result2 error
+	}
+	CreateDBInstanceReadReplicaStub        func(input *rds.CreateDBInstanceReadReplicaInput) (*rds.CreateDBInstanceReadReplicaOutput, error)
+	createDBInstanceReadReplicaMutex       sync.RWMutex
+	createDBInstanceReadReplicaArgsForCall []struct {
+		input *rds.CreateDBInstanceReadReplicaInput
+	}
+	createDBInstanceReadReplicaReturns struct {
+		result1 *rds.CreateDBInstanceReadReplicaOutput
+		result2 error
+	}
+	createDBInstanceReadReplicaReturnsOnCall map[int]struct {
+		result1 *rds.CreateDBInstanceReadReplicaOutput
+		result2 error
+	}
+	PromoteReadReplicaStub        func(input *rds.PromoteReadReplicaInput) (*rds.PromoteReadReplicaOutput, error)
+	promoteReadReplicaMutex       sync.RWMutex
+	promoteReadReplicaArgsForCall []struct {
+		input *rds.PromoteReadReplicaInput
+	}
+	promoteReadReplicaReturns struct {
+		result1 *rds.PromoteReadReplicaOutput
+		result2 error
+	}
+	promoteReadReplicaReturnsOnCall map[int]struct {
+		result1 *rds.PromoteReadReplicaOutput
+		result2 error
+	}
+	DescribeDBEngineVersionsStub        func(input *rds.DescribeDBEngineVersionsInput) (*rds.DescribeDBEngineVersionsOutput, error)
+	describeDBEngineVersionsMutex       sync.RWMutex
+	describeDBEngineVersionsArgsForCall []struct {
+		input *rds.DescribeDBEngineVersionsInput
 	}
-	waitUntilDBInstanceAvailableReturns struct {
+	describeDBEngineVersionsReturns struct {
+		result1 *rds.DescribeDBEngineVersionsOutput
+		result2 error
+	}
+	describeDBEngineVersionsReturnsOnCall map[int]struct {
+		result1 *rds.DescribeDBEngineVersionsOutput
+		result2 error
+	}
+	CreateDBParameterGroupStub        func(input *rds.CreateDBParameterGroupInput) (*rds.CreateDBParameterGroupOutput, error)
+	createDBParameterGroupMutex       sync.RWMutex
+	createDBParameterGroupArgsForCall []struct {
+		input *rds.CreateDBParameterGroupInput
+	}
+	createDBParameterGroupReturns struct {
+		result1 *rds.CreateDBParameterGroupOutput
+		result2 error
+	}
+	createDBParameterGroupReturnsOnCall map[int]struct {
+		result1 *rds.CreateDBParameterGroupOutput
+		result2 error
+	}
+	DescribeDBParametersPagesStub        func(input *rds.DescribeDBParametersInput, fn func(*rds.DescribeDBParametersOutput, bool) bool) error
+	describeDBParametersPagesMutex       sync.RWMutex
+	describeDBParametersPagesArgsForCall []struct {
+		input *rds.DescribeDBParametersInput
+		fn    func(*rds.DescribeDBParametersOutput, bool) bool
+	}
+	describeDBParametersPagesReturns struct {
 		result1 error
 	}
-	waitUntilDBInstanceAvailableReturnsOnCall map[int]struct {
+	describeDBParametersPagesReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ModifyDBParameterGroupStub        func(input *rds.ModifyDBParameterGroupInput) (*rds.DBParameterGroupNameMessage, error)
+	modifyDBParameterGroupMutex       sync.RWMutex
+	modifyDBParameterGroupArgsForCall []struct {
+		input *rds.ModifyDBParameterGroupInput
+	}
+	modifyDBParameterGroupReturns struct {
+		result1 *rds.DBParameterGroupNameMessage
+		result2 error
+	}
+	modifyDBParameterGroupReturnsOnCall map[int]struct {
+		result1 *rds.DBParameterGroupNameMessage
+		result2 error
+	}
+	DeleteDBParameterGroupStub        func(input *rds.DeleteDBParameterGroupInput) (*rds.DeleteDBParameterGroupOutput, error)
+	deleteDBParameterGroupMutex       sync.RWMutex
+	deleteDBParameterGroupArgsForCall []struct {
+		input *rds.DeleteDBParameterGroupInput
+	}
+	deleteDBParameterGroupReturns struct {
+		result1 *rds.DeleteDBParameterGroupOutput
+		result2 error
+	}
+	deleteDBParameterGroupReturnsOnCall map[int]struct {
+		result1 *rds.DeleteDBParameterGroupOutput
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeRDSService) DescribeDBSubnetGroups(input *rds.DescribeDBSubnetGroupsInput) (*rds.DescribeDBSubnetGroupsOutput, error) {
-	fake.describeDBSubnetGroupsMutex.Lock()
-	ret, specificReturn := fake.describeDBSubnetGroupsReturnsOnCall[len(fake.describeDBSubnetGroupsArgsForCall)]
-	fake.describeDBSubnetGroupsArgsForCall = append(fake.describeDBSubnetGroupsArgsForCall, struct {
+func (fake *FakeRDSService) DescribeDBSubnetGroupsPages(input *rds.DescribeDBSubnetGroupsInput, fn func(*rds.DescribeDBSubnetGroupsOutput, bool) bool) error {
+	fake.describeDBSubnetGroupsPagesMutex.Lock()
+	ret, specificReturn := fake.describeDBSubnetGroupsPagesReturnsOnCall[len(fake.describeDBSubnetGroupsPagesArgsForCall)]
+	fake.describeDBSubnetGroupsPagesArgsForCall = append(fake.describeDBSubnetGroupsPagesArgsForCall, struct {
 		input *rds.DescribeDBSubnetGroupsInput
+		fn    func(*rds.DescribeDBSubnetGroupsOutput, bool) bool
+	}{input, fn})
+	fake.recordInvocation("DescribeDBSubnetGroupsPages", []interface{}{input, fn})
+	fake.describeDBSubnetGroupsPagesMutex.Unlock()
+	if fake.DescribeDBSubnetGroupsPagesStub != nil {
+		return fake.DescribeDBSubnetGroupsPagesStub(input, fn)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.describeDBSubnetGroupsPagesReturns.result1
+}
+
+func (fake *FakeRDSService) DescribeDBSubnetGroupsPagesCallCount() int {
+	fake.describeDBSubnetGroupsPagesMutex.RLock()
+	defer fake.describeDBSubnetGroupsPagesMutex.RUnlock()
+	return len(fake.describeDBSubnetGroupsPagesArgsForCall)
+}
+
+func (fake *FakeRDSService) DescribeDBSubnetGroupsPagesArgsForCall(i int) (*rds.DescribeDBSubnetGroupsInput, func(*rds.DescribeDBSubnetGroupsOutput, bool) bool) {
+	fake.describeDBSubnetGroupsPagesMutex.RLock()
+	defer fake.describeDBSubnetGroupsPagesMutex.RUnlock()
+	return fake.describeDBSubnetGroupsPagesArgsForCall[i].input, fake.describeDBSubnetGroupsPagesArgsForCall[i].fn
+}
+
+func (fake *FakeRDSService) DescribeDBSubnetGroupsPagesReturns(result1 error) {
+	fake.DescribeDBSubnetGroupsPagesStub = nil
+	fake.describeDBSubnetGroupsPagesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRDSService) DescribeDBSubnetGroupsPagesReturnsOnCall(i int, result1 error) {
+	fake.DescribeDBSubnetGroupsPagesStub = nil
+	if fake.describeDBSubnetGroupsPagesReturnsOnCall == nil {
+		fake.describeDBSubnetGroupsPagesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.describeDBSubnetGroupsPagesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRDSService) ListTagsForResource(input *rds.ListTagsForResourceInput) (*rds.ListTagsForResourceOutput, error) {
+	fake.listTagsForResourceMutex.Lock()
+	ret, specificReturn := fake.listTagsForResourceReturnsOnCall[len(fake.listTagsForResourceArgsForCall)]
+	fake.listTagsForResourceArgsForCall = append(fake.listTagsForResourceArgsForCall, struct {
+		input *rds.ListTagsForResourceInput
 	}{input})
-	fake.recordInvocation("DescribeDBSubnetGroups", []interface{}{input})
-	fake.describeDBSubnetGroupsMutex.Unlock()
-	if fake.DescribeDBSubnetGroupsStub != nil {
-		return fake.DescribeDBSubnetGroupsStub(input)
+	fake.recordInvocation("ListTagsForResource", []interface{}{input})
+	fake.listTagsForResourceMutex.Unlock()
+	if fake.ListTagsForResourceStub != nil {
+		return fake.ListTagsForResourceStub(input)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	return fake.describeDBSubnetGroupsReturns.result1, fake.describeDBSubnetGroupsReturns.result2
+	return fake.listTagsForResourceReturns.result1, fake.listTagsForResourceReturns.result2
 }
 
-func (fake *FakeRDSService) DescribeDBSubnetGroupsCallCount() int {
-	fake.describeDBSubnetGroupsMutex.RLock()
-	defer fake.describeDBSubnetGroupsMutex.RUnlock()
-	return len(fake.describeDBSubnetGroupsArgsForCall)
+func (fake *FakeRDSService) ListTagsForResourceCallCount() int {
+	fake.listTagsForResourceMutex.RLock()
+	defer fake.listTagsForResourceMutex.RUnlock()
+	return len(fake.listTagsForResourceArgsForCall)
 }
 
-func (fake *FakeRDSService) DescribeDBSubnetGroupsArgsForCall(i int) *rds.DescribeDBSubnetGroupsInput {
-	fake.describeDBSubnetGroupsMutex.RLock()
-	defer fake.describeDBSubnetGroupsMutex.RUnlock()
-	return fake.describeDBSubnetGroupsArgsForCall[i].input
+func (fake *FakeRDSService) ListTagsForResourceArgsForCall(i int) *rds.ListTagsForResourceInput {
+	fake.listTagsForResourceMutex.RLock()
+	defer fake.listTagsForResourceMutex.RUnlock()
+	return fake.listTagsForResourceArgsForCall[i].input
 }
 
-func (fake *FakeRDSService) DescribeDBSubnetGroupsReturns(result1 *rds.DescribeDBSubnetGroupsOutput, result2 error) {
-	fake.DescribeDBSubnetGroupsStub = nil
-	fake.describeDBSubnetGroupsReturns = struct {
-		result1 *rds.DescribeDBSubnetGroupsOutput
+func (fake *FakeRDSService) ListTagsForResourceReturns(result1 *rds.ListTagsForResourceOutput, result2 error) {
+	fake.ListTagsForResourceStub = nil
+	fake.listTagsForResourceReturns = struct {
+		result1 *rds.ListTagsForResourceOutput
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeRDSService) DescribeDBSubnetGroupsReturnsOnCall(i int, result1 *rds.DescribeDBSubnetGroupsOutput, result2 error) {
-	fake.DescribeDBSubnetGroupsStub = nil
-	if fake.describeDBSubnetGroupsReturnsOnCall == nil {
-		fake.describeDBSubnetGroupsReturnsOnCall = make(map[int]struct {
-			result1 *rds.DescribeDBSubnetGroupsOutput
+func (fake *FakeRDSService) ListTagsForResourceReturnsOnCall(i int, result1 *rds.ListTagsForResourceOutput, result2 error) {
+	fake.ListTagsForResourceStub = nil
+	if fake.listTagsForResourceReturnsOnCall == nil {
+		fake.listTagsForResourceReturnsOnCall = make(map[int]struct {
+			result1 *rds.ListTagsForResourceOutput
 			result2 error
 		})
 	}
-	fake.describeDBSubnetGroupsReturnsOnCall[i] = struct {
-		result1 *rds.DescribeDBSubnetGroupsOutput
+	fake.listTagsForResourceReturnsOnCall[i] = struct {
+		result1 *rds.ListTagsForResourceOutput
 		result2 error
 	}{result1, result2}
 }
@@ -280,67 +630,1234 @@ func (fake *FakeRDSService) ModifyDBInstanceReturnsOnCall(i int, result1 *rds.Mo
 	}{result1, result2}
 }
 
-func (fake *FakeRDSService) WaitUntilDBInstanceAvailable(input *rds.DescribeDBInstancesInput) error {
-	fake.waitUntilDBInstanceAvailableMutex.Lock()
-	ret, specificReturn := fake.waitUntilDBInstanceAvailableReturnsOnCall[len(fake.waitUntilDBInstanceAvailableArgsForCall)]
-	fake.waitUntilDBInstanceAvailableArgsForCall = append(fake.waitUntilDBInstanceAvailableArgsForCall, struct {
+func (fake *FakeRDSService) WaitUntilDBInstanceAvailableWithContext(ctx aws.Context, input *rds.DescribeDBInstancesInput, opts ...request.WaiterOption) error {
+	fake.waitUntilDBInstanceAvailableWithContextMutex.Lock()
+	ret, specificReturn := fake.waitUntilDBInstanceAvailableWithContextReturnsOnCall[len(fake.waitUntilDBInstanceAvailableWithContextArgsForCall)]
+	fake.waitUntilDBInstanceAvailableWithContextArgsForCall = append(fake.waitUntilDBInstanceAvailableWithContextArgsForCall, struct {
+		ctx   aws.Context
 		input *rds.DescribeDBInstancesInput
+		opts  []request.WaiterOption
+	}{ctx, input, opts})
+	fake.recordInvocation("WaitUntilDBInstanceAvailableWithContext", []interface{}{ctx, input, opts})
+	fake.waitUntilDBInstanceAvailableWithContextMutex.Unlock()
+	if fake.WaitUntilDBInstanceAvailableWithContextStub != nil {
+		return fake.WaitUntilDBInstanceAvailableWithContextStub(ctx, input, opts...)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.waitUntilDBInstanceAvailableWithContextReturns.result1
+}
+
+func (fake *FakeRDSService) WaitUntilDBInstanceAvailableWithContextCallCount() int {
+	fake.waitUntilDBInstanceAvailableWithContextMutex.RLock()
+	defer fake.waitUntilDBInstanceAvailableWithContextMutex.RUnlock()
+	return len(fake.waitUntilDBInstanceAvailableWithContextArgsForCall)
+}
+
+func (fake *FakeRDSService) WaitUntilDBInstanceAvailableWithContextArgsForCall(i int) (aws.Context, *rds.DescribeDBInstancesInput, []request.WaiterOption) {
+	fake.waitUntilDBInstanceAvailableWithContextMutex.RLock()
+	defer fake.waitUntilDBInstanceAvailableWithContextMutex.RUnlock()
+	return fake.waitUntilDBInstanceAvailableWithContextArgsForCall[i].ctx, fake.waitUntilDBInstanceAvailableWithContextArgsForCall[i].input, fake.waitUntilDBInstanceAvailableWithContextArgsForCall[i].opts
+}
+
+func (fake *FakeRDSService) WaitUntilDBInstanceAvailableWithContextReturns(result1 error) {
+	fake.WaitUntilDBInstanceAvailableWithContextStub = nil
+	fake.waitUntilDBInstanceAvailableWithContextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRDSService) WaitUntilDBInstanceAvailableWithContextReturnsOnCall(i int, result1 error) {
+	fake.WaitUntilDBInstanceAvailableWithContextStub = nil
+	if fake.waitUntilDBInstanceAvailableWithContextReturnsOnCall == nil {
+		fake.waitUntilDBInstanceAvailableWithContextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.waitUntilDBInstanceAvailableWithContextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRDSService) DeleteDBInstance(input *rds.DeleteDBInstanceInput) (*rds.DeleteDBInstanceOutput, error) {
+	fake.deleteDBInstanceMutex.Lock()
+	ret, specificReturn := fake.deleteDBInstanceReturnsOnCall[len(fake.deleteDBInstanceArgsForCall)]
+	fake.deleteDBInstanceArgsForCall = append(fake.deleteDBInstanceArgsForCall, struct {
+		input *rds.DeleteDBInstanceInput
 	}{input})
-	fake.recordInvocation("WaitUntilDBInstanceAvailable", []interface{}{input})
-	fake.waitUntilDBInstanceAvailableMutex.Unlock()
-	if fake.WaitUntilDBInstanceAvailableStub != nil {
-		return fake.WaitUntilDBInstanceAvailableStub(input)
+	fake.recordInvocation("DeleteDBInstance", []interface{}{input})
+	fake.deleteDBInstanceMutex.Unlock()
+	if fake.DeleteDBInstanceStub != nil {
+		return fake.DeleteDBInstanceStub(input)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteDBInstanceReturns.result1, fake.deleteDBInstanceReturns.result2
+}
+
+func (fake *FakeRDSService) DeleteDBInstanceCallCount() int {
+	fake.deleteDBInstanceMutex.RLock()
+	defer fake.deleteDBInstanceMutex.RUnlock()
+	return len(fake.deleteDBInstanceArgsForCall)
+}
+
+func (fake *FakeRDSService) DeleteDBInstanceArgsForCall(i int) *rds.DeleteDBInstanceInput {
+	fake.deleteDBInstanceMutex.RLock()
+	defer fake.deleteDBInstanceMutex.RUnlock()
+	return fake.deleteDBInstanceArgsForCall[i].input
+}
+
+func (fake *FakeRDSService) DeleteDBInstanceReturns(result1 *rds.DeleteDBInstanceOutput, result2 error) {
+	fake.DeleteDBInstanceStub = nil
+	fake.deleteDBInstanceReturns = struct {
+		result1 *rds.DeleteDBInstanceOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRDSService) DeleteDBInstanceReturnsOnCall(i int, result1 *rds.DeleteDBInstanceOutput, result2 error) {
+	fake.DeleteDBInstanceStub = nil
+	if fake.deleteDBInstanceReturnsOnCall == nil {
+		fake.deleteDBInstanceReturnsOnCall = make(map[int]struct {
+			result1 *rds.DeleteDBInstanceOutput
+			result2 error
+		})
+	}
+	fake.deleteDBInstanceReturnsOnCall[i] = struct {
+		result1 *rds.DeleteDBInstanceOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRDSService) WaitUntilDBInstanceDeletedWithContext(ctx aws.Context, input *rds.DescribeDBInstancesInput, opts ...request.WaiterOption) error {
+	fake.waitUntilDBInstanceDeletedWithContextMutex.Lock()
+	ret, specificReturn := fake.waitUntilDBInstanceDeletedWithContextReturnsOnCall[len(fake.waitUntilDBInstanceDeletedWithContextArgsForCall)]
+	fake.waitUntilDBInstanceDeletedWithContextArgsForCall = append(fake.waitUntilDBInstanceDeletedWithContextArgsForCall, struct {
+		ctx   aws.Context
+		input *rds.DescribeDBInstancesInput
+		opts  []request.WaiterOption
+	}{ctx, input, opts})
+	fake.recordInvocation("WaitUntilDBInstanceDeletedWithContext", []interface{}{ctx, input, opts})
+	fake.waitUntilDBInstanceDeletedWithContextMutex.Unlock()
+	if fake.WaitUntilDBInstanceDeletedWithContextStub != nil {
+		return fake.WaitUntilDBInstanceDeletedWithContextStub(ctx, input, opts...)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	return fake.waitUntilDBInstanceAvailableReturns.result1
+	return fake.waitUntilDBInstanceDeletedWithContextReturns.result1
 }
 
-func (fake *FakeRDSService) WaitUntilDBInstanceAvailableCallCount() int {
-	fake.waitUntilDBInstanceAvailableMutex.RLock()
-	defer fake.waitUntilDBInstanceAvailableMutex.RUnlock()
-	return len(fake.waitUntilDBInstanceAvailableArgsForCall)
+func (fake *FakeRDSService) WaitUntilDBInstanceDeletedWithContextCallCount() int {
+	fake.waitUntilDBInstanceDeletedWithContextMutex.RLock()
+	defer fake.waitUntilDBInstanceDeletedWithContextMutex.RUnlock()
+	return len(fake.waitUntilDBInstanceDeletedWithContextArgsForCall)
 }
 
-func (fake *FakeRDSService) WaitUntilDBInstanceAvailableArgsForCall(i int) *rds.DescribeDBInstancesInput {
-	fake.waitUntilDBInstanceAvailableMutex.RLock()
-	defer fake.waitUntilDBInstanceAvailableMutex.RUnlock()
-	return fake.waitUntilDBInstanceAvailableArgsForCall[i].input
+func (fake *FakeRDSService) WaitUntilDBInstanceDeletedWithContextArgsForCall(i int) (aws.Context, *rds.DescribeDBInstancesInput, []request.WaiterOption) {
+	fake.waitUntilDBInstanceDeletedWithContextMutex.RLock()
+	defer fake.waitUntilDBInstanceDeletedWithContextMutex.RUnlock()
+	return fake.waitUntilDBInstanceDeletedWithContextArgsForCall[i].ctx, fake.waitUntilDBInstanceDeletedWithContextArgsForCall[i].input, fake.waitUntilDBInstanceDeletedWithContextArgsForCall[i].opts
 }
 
-func (fake *FakeRDSService) WaitUntilDBInstanceAvailableReturns(result1 error) {
-	fake.WaitUntilDBInstanceAvailableStub = nil
-	fake.waitUntilDBInstanceAvailableReturns = struct {
+func (fake *FakeRDSService) WaitUntilDBInstanceDeletedWithContextReturns(result1 error) {
+	fake.WaitUntilDBInstanceDeletedWithContextStub = nil
+	fake.waitUntilDBInstanceDeletedWithContextReturns = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeRDSService) WaitUntilDBInstanceAvailableReturnsOnCall(i int, result1 error) {
-	fake.WaitUntilDBInstanceAvailableStub = nil
-	if fake.waitUntilDBInstanceAvailableReturnsOnCall == nil {
-		fake.waitUntilDBInstanceAvailableReturnsOnCall = make(map[int]struct {
+func (fake *FakeRDSService) WaitUntilDBInstanceDeletedWithContextReturnsOnCall(i int, result1 error) {
+	fake.WaitUntilDBInstanceDeletedWithContextStub = nil
+	if fake.waitUntilDBInstanceDeletedWithContextReturnsOnCall == nil {
+		fake.waitUntilDBInstanceDeletedWithContextReturnsOnCall = make(map[int]struct {
 			result1 error
 		})
 	}
-	fake.waitUntilDBInstanceAvailableReturnsOnCall[i] = struct {
+	fake.waitUntilDBInstanceDeletedWithContextReturnsOnCall[i] = struct {
 		result1 error
 	}{result1}
 }
 
+func (fake *FakeRDSService) CreateDBSnapshot(input *rds.CreateDBSnapshotInput) (*rds.CreateDBSnapshotOutput, error) {
+	fake.createDBSnapshotMutex.Lock()
+	ret, specificReturn := fake.createDBSnapshotReturnsOnCall[len(fake.createDBSnapshotArgsForCall)]
+	fake.createDBSnapshotArgsForCall = append(fake.createDBSnapshotArgsForCall, struct {
+		input *rds.CreateDBSnapshotInput
+	}{input})
+	fake.recordInvocation("CreateDBSnapshot", []interface{}{input})
+	fake.createDBSnapshotMutex.Unlock()
+	if fake.CreateDBSnapshotStub != nil {
+		return fake.CreateDBSnapshotStub(input)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.createDBSnapshotReturns.result1, fake.createDBSnapshotReturns.result2
+}
+
+func (fake *FakeRDSService) CreateDBSnapshotCallCount() int {
+	fake.createDBSnapshotMutex.RLock()
+	defer fake.createDBSnapshotMutex.RUnlock()
+	return len(fake.createDBSnapshotArgsForCall)
+}
+
+func (fake *FakeRDSService) CreateDBSnapshotArgsForCall(i int) *rds.CreateDBSnapshotInput {
+	fake.createDBSnapshotMutex.RLock()
+	defer fake.createDBSnapshotMutex.RUnlock()
+	return fake.createDBSnapshotArgsForCall[i].input
+}
+
+func (fake *FakeRDSService) CreateDBSnapshotReturns(result1 *rds.CreateDBSnapshotOutput, result2 error) {
+	fake.CreateDBSnapshotStub = nil
+	fake.createDBSnapshotReturns = struct {
+		result1 *rds.CreateDBSnapshotOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRDSService) CreateDBSnapshotReturnsOnCall(i int, result1 *rds.CreateDBSnapshotOutput, result2 error) {
+	fake.CreateDBSnapshotStub = nil
+	if fake.createDBSnapshotReturnsOnCall == nil {
+		fake.createDBSnapshotReturnsOnCall = make(map[int]struct {
+			result1 *rds.CreateDBSnapshotOutput
+			result2 error
+		})
+	}
+	fake.createDBSnapshotReturnsOnCall[i] = struct {
+		result1 *rds.CreateDBSnapshotOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRDSService) DescribeDBSnapshots(input *rds.DescribeDBSnapshotsInput) (*rds.DescribeDBSnapshotsOutput, error) {
+	fake.describeDBSnapshotsMutex.Lock()
+	ret, specificReturn := fake.describeDBSnapshotsReturnsOnCall[len(fake.describeDBSnapshotsArgsForCall)]
+	fake.describeDBSnapshotsArgsForCall = append(fake.describeDBSnapshotsArgsForCall, struct {
+		input *rds.DescribeDBSnapshotsInput
+	}{input})
+	fake.recordInvocation("DescribeDBSnapshots", []interface{}{input})
+	fake.describeDBSnapshotsMutex.Unlock()
+	if fake.DescribeDBSnapshotsStub != nil {
+		return fake.DescribeDBSnapshotsStub(input)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.describeDBSnapshotsReturns.result1, fake.describeDBSnapshotsReturns.result2
+}
+
+func (fake *FakeRDSService) DescribeDBSnapshotsCallCount() int {
+	fake.describeDBSnapshotsMutex.RLock()
+	defer fake.describeDBSnapshotsMutex.RUnlock()
+	return len(fake.describeDBSnapshotsArgsForCall)
+}
+
+func (fake *FakeRDSService) DescribeDBSnapshotsArgsForCall(i int) *rds.DescribeDBSnapshotsInput {
+	fake.describeDBSnapshotsMutex.RLock()
+	defer fake.describeDBSnapshotsMutex.RUnlock()
+	return fake.describeDBSnapshotsArgsForCall[i].input
+}
+
+func (fake *FakeRDSService) DescribeDBSnapshotsReturns(result1 *rds.DescribeDBSnapshotsOutput, result2 error) {
+	fake.DescribeDBSnapshotsStub = nil
+	fake.describeDBSnapshotsReturns = struct {
+		result1 *rds.DescribeDBSnapshotsOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRDSService) DescribeDBSnapshotsReturnsOnCall(i int, result1 *rds.DescribeDBSnapshotsOutput, result2 error) {
+	fake.DescribeDBSnapshotsStub = nil
+	if fake.describeDBSnapshotsReturnsOnCall == nil {
+		fake.describeDBSnapshotsReturnsOnCall = make(map[int]struct {
+			result1 *rds.DescribeDBSnapshotsOutput
+			result2 error
+		})
+	}
+	fake.describeDBSnapshotsReturnsOnCall[i] = struct {
+		result1 *rds.DescribeDBSnapshotsOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRDSService) DeleteDBSnapshot(input *rds.DeleteDBSnapshotInput) (*rds.DeleteDBSnapshotOutput, error) {
+	fake.deleteDBSnapshotMutex.Lock()
+	ret, specificReturn := fake.deleteDBSnapshotReturnsOnCall[len(fake.deleteDBSnapshotArgsForCall)]
+	fake.deleteDBSnapshotArgsForCall = append(fake.deleteDBSnapshotArgsForCall, struct {
+		input *rds.DeleteDBSnapshotInput
+	}{input})
+	fake.recordInvocation("DeleteDBSnapshot", []interface{}{input})
+	fake.deleteDBSnapshotMutex.Unlock()
+	if fake.DeleteDBSnapshotStub != nil {
+		return fake.DeleteDBSnapshotStub(input)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteDBSnapshotReturns.result1, fake.deleteDBSnapshotReturns.result2
+}
+
+func (fake *FakeRDSService) DeleteDBSnapshotCallCount() int {
+	fake.deleteDBSnapshotMutex.RLock()
+	defer fake.deleteDBSnapshotMutex.RUnlock()
+	return len(fake.deleteDBSnapshotArgsForCall)
+}
+
+func (fake *FakeRDSService) DeleteDBSnapshotArgsForCall(i int) *rds.DeleteDBSnapshotInput {
+	fake.deleteDBSnapshotMutex.RLock()
+	defer fake.deleteDBSnapshotMutex.RUnlock()
+	return fake.deleteDBSnapshotArgsForCall[i].input
+}
+
+func (fake *FakeRDSService) DeleteDBSnapshotReturns(result1 *rds.DeleteDBSnapshotOutput, result2 error) {
+	fake.DeleteDBSnapshotStub = nil
+	fake.deleteDBSnapshotReturns = struct {
+		result1 *rds.DeleteDBSnapshotOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRDSService) DeleteDBSnapshotReturnsOnCall(i int, result1 *rds.DeleteDBSnapshotOutput, result2 error) {
+	fake.DeleteDBSnapshotStub = nil
+	if fake.deleteDBSnapshotReturnsOnCall == nil {
+		fake.deleteDBSnapshotReturnsOnCall = make(map[int]struct {
+			result1 *rds.DeleteDBSnapshotOutput
+			result2 error
+		})
+	}
+	fake.deleteDBSnapshotReturnsOnCall[i] = struct {
+		result1 *rds.DeleteDBSnapshotOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRDSService) RestoreDBInstanceFromDBSnapshot(input *rds.RestoreDBInstanceFromDBSnapshotInput) (*rds.RestoreDBInstanceFromDBSnapshotOutput, error) {
+	fake.restoreDBInstanceFromDBSnapshotMutex.Lock()
+	ret, specificReturn := fake.restoreDBInstanceFromDBSnapshotReturnsOnCall[len(fake.restoreDBInstanceFromDBSnapshotArgsForCall)]
+	fake.restoreDBInstanceFromDBSnapshotArgsForCall = append(fake.restoreDBInstanceFromDBSnapshotArgsForCall, struct {
+		input *rds.RestoreDBInstanceFromDBSnapshotInput
+	}{input})
+	fake.recordInvocation("RestoreDBInstanceFromDBSnapshot", []interface{}{input})
+	fake.restoreDBInstanceFromDBSnapshotMutex.Unlock()
+	if fake.RestoreDBInstanceFromDBSnapshotStub != nil {
+		return fake.RestoreDBInstanceFromDBSnapshotStub(input)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.restoreDBInstanceFromDBSnapshotReturns.result1, fake.restoreDBInstanceFromDBSnapshotReturns.result2
+}
+
+func (fake *FakeRDSService) RestoreDBInstanceFromDBSnapshotCallCount() int {
+	fake.restoreDBInstanceFromDBSnapshotMutex.RLock()
+	defer fake.restoreDBInstanceFromDBSnapshotMutex.RUnlock()
+	return len(fake.restoreDBInstanceFromDBSnapshotArgsForCall)
+}
+
+func (fake *FakeRDSService) RestoreDBInstanceFromDBSnapshotArgsForCall(i int) *rds.RestoreDBInstanceFromDBSnapshotInput {
+	fake.restoreDBInstanceFromDBSnapshotMutex.RLock()
+	defer fake.restoreDBInstanceFromDBSnapshotMutex.RUnlock()
+	return fake.restoreDBInstanceFromDBSnapshotArgsForCall[i].input
+}
+
+func (fake *FakeRDSService) RestoreDBInstanceFromDBSnapshotReturns(result1 *rds.RestoreDBInstanceFromDBSnapshotOutput, result2 error) {
+	fake.RestoreDBInstanceFromDBSnapshotStub = nil
+	fake.restoreDBInstanceFromDBSnapshotReturns = struct {
+		result1 *rds.RestoreDBInstanceFromDBSnapshotOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRDSService) RestoreDBInstanceFromDBSnapshotReturnsOnCall(i int, result1 *rds.RestoreDBInstanceFromDBSnapshotOutput, result2 error) {
+	fake.RestoreDBInstanceFromDBSnapshotStub = nil
+	if fake.restoreDBInstanceFromDBSnapshotReturnsOnCall == nil {
+		fake.restoreDBInstanceFromDBSnapshotReturnsOnCall = make(map[int]struct {
+			result1 *rds.RestoreDBInstanceFromDBSnapshotOutput
+			result2 error
+		})
+	}
+	fake.restoreDBInstanceFromDBSnapshotReturnsOnCall[i] = struct {
+		result1 *rds.RestoreDBInstanceFromDBSnapshotOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRDSService) RestoreDBInstanceToPointInTime(input *rds.RestoreDBInstanceToPointInTimeInput) (*rds.RestoreDBInstanceToPointInTimeOutput, error) {
+	fake.restoreDBInstanceToPointInTimeMutex.Lock()
+	ret, specificReturn := fake.restoreDBInstanceToPointInTimeReturnsOnCall[len(fake.restoreDBInstanceToPointInTimeArgsForCall)]
+	fake.restoreDBInstanceToPointInTimeArgsForCall = append(fake.restoreDBInstanceToPointInTimeArgsForCall, struct {
+		input *rds.RestoreDBInstanceToPointInTimeInput
+	}{input})
+	fake.recordInvocation("RestoreDBInstanceToPointInTime", []interface{}{input})
+	fake.restoreDBInstanceToPointInTimeMutex.Unlock()
+	if fake.RestoreDBInstanceToPointInTimeStub != nil {
+		return fake.RestoreDBInstanceToPointInTimeStub(input)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.restoreDBInstanceToPointInTimeReturns.result1, fake.restoreDBInstanceToPointInTimeReturns.result2
+}
+
+func (fake *FakeRDSService) RestoreDBInstanceToPointInTimeCallCount() int {
+	fake.restoreDBInstanceToPointInTimeMutex.RLock()
+	defer fake.restoreDBInstanceToPointInTimeMutex.RUnlock()
+	return len(fake.restoreDBInstanceToPointInTimeArgsForCall)
+}
+
+func (fake *FakeRDSService) RestoreDBInstanceToPointInTimeArgsForCall(i int) *rds.RestoreDBInstanceToPointInTimeInput {
+	fake.restoreDBInstanceToPointInTimeMutex.RLock()
+	defer fake.restoreDBInstanceToPointInTimeMutex.RUnlock()
+	return fake.restoreDBInstanceToPointInTimeArgsForCall[i].input
+}
+
+func (fake *FakeRDSService) RestoreDBInstanceToPointInTimeReturns(result1 *rds.RestoreDBInstanceToPointInTimeOutput, result2 error) {
+	fake.RestoreDBInstanceToPointInTimeStub = nil
+	fake.restoreDBInstanceToPointInTimeReturns = struct {
+		result1 *rds.RestoreDBInstanceToPointInTimeOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRDSService) RestoreDBInstanceToPointInTimeReturnsOnCall(i int, result1 *rds.RestoreDBInstanceToPointInTimeOutput, result2 error) {
+	fake.RestoreDBInstanceToPointInTimeStub = nil
+	if fake.restoreDBInstanceToPointInTimeReturnsOnCall == nil {
+		fake.restoreDBInstanceToPointInTimeReturnsOnCall = make(map[int]struct {
+			result1 *rds.RestoreDBInstanceToPointInTimeOutput
+			result2 error
+		})
+	}
+	fake.restoreDBInstanceToPointInTimeReturnsOnCall[i] = struct {
+		result1 *rds.RestoreDBInstanceToPointInTimeOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRDSService) StopDBInstance(input *rds.StopDBInstanceInput) (*rds.StopDBInstanceOutput, error) {
+	fake.stopDBInstanceMutex.Lock()
+	ret, specificReturn := fake.stopDBInstanceReturnsOnCall[len(fake.stopDBInstanceArgsForCall)]
+	fake.stopDBInstanceArgsForCall = append(fake.stopDBInstanceArgsForCall, struct {
+		input *rds.StopDBInstanceInput
+	}{input})
+	fake.recordInvocation("StopDBInstance", []interface{}{input})
+	fake.stopDBInstanceMutex.Unlock()
+	if fake.StopDBInstanceStub != nil {
+		return fake.StopDBInstanceStub(input)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.stopDBInstanceReturns.result1, fake.stopDBInstanceReturns.result2
+}
+
+func (fake *FakeRDSService) StopDBInstanceCallCount() int {
+	fake.stopDBInstanceMutex.RLock()
+	defer fake.stopDBInstanceMutex.RUnlock()
+	return len(fake.stopDBInstanceArgsForCall)
+}
+
+func (fake *FakeRDSService) StopDBInstanceArgsForCall(i int) *rds.StopDBInstanceInput {
+	fake.stopDBInstanceMutex.RLock()
+	defer fake.stopDBInstanceMutex.RUnlock()
+	return fake.stopDBInstanceArgsForCall[i].input
+}
+
+func (fake *FakeRDSService) StopDBInstanceReturns(result1 *rds.StopDBInstanceOutput, result2 error) {
+	fake.StopDBInstanceStub = nil
+	fake.stopDBInstanceReturns = struct {
+		result1 *rds.StopDBInstanceOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRDSService) StopDBInstanceReturnsOnCall(i int, result1 *rds.StopDBInstanceOutput, result2 error) {
+	fake.StopDBInstanceStub = nil
+	if fake.stopDBInstanceReturnsOnCall == nil {
+		fake.stopDBInstanceReturnsOnCall = make(map[int]struct {
+			result1 *rds.StopDBInstanceOutput
+			result2 error
+		})
+	}
+	fake.stopDBInstanceReturnsOnCall[i] = struct {
+		result1 *rds.StopDBInstanceOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRDSService) StartDBInstance(input *rds.StartDBInstanceInput) (*rds.StartDBInstanceOutput, error) {
+	fake.startDBInstanceMutex.Lock()
+	ret, specificReturn := fake.startDBInstanceReturnsOnCall[len(fake.startDBInstanceArgsForCall)]
+	fake.startDBInstanceArgsForCall = append(fake.startDBInstanceArgsForCall, struct {
+		input *rds.StartDBInstanceInput
+	}{input})
+	fake.recordInvocation("StartDBInstance", []interface{}{input})
+	fake.startDBInstanceMutex.Unlock()
+	if fake.StartDBInstanceStub != nil {
+		return fake.StartDBInstanceStub(input)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.startDBInstanceReturns.result1, fake.startDBInstanceReturns.result2
+}
+
+func (fake *FakeRDSService) StartDBInstanceCallCount() int {
+	fake.startDBInstanceMutex.RLock()
+	defer fake.startDBInstanceMutex.RUnlock()
+	return len(fake.startDBInstanceArgsForCall)
+}
+
+func (fake *FakeRDSService) StartDBInstanceArgsForCall(i int) *rds.StartDBInstanceInput {
+	fake.startDBInstanceMutex.RLock()
+	defer fake.startDBInstanceMutex.RUnlock()
+	return fake.startDBInstanceArgsForCall[i].input
+}
+
+func (fake *FakeRDSService) StartDBInstanceReturns(result1 *rds.StartDBInstanceOutput, result2 error) {
+	fake.StartDBInstanceStub = nil
+	fake.startDBInstanceReturns = struct {
+		result1 *rds.StartDBInstanceOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRDSService) StartDBInstanceReturnsOnCall(i int, result1 *rds.StartDBInstanceOutput, result2 error) {
+	fake.StartDBInstanceStub = nil
+	if fake.startDBInstanceReturnsOnCall == nil {
+		fake.startDBInstanceReturnsOnCall = make(map[int]struct {
+			result1 *rds.StartDBInstanceOutput
+			result2 error
+		})
+	}
+	fake.startDBInstanceReturnsOnCall[i] = struct {
+		result1 *rds.StartDBInstanceOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRDSService) RebootDBInstance(input *rds.RebootDBInstanceInput) (*rds.RebootDBInstanceOutput, error) {
+	fake.rebootDBInstanceMutex.Lock()
+	ret, specificReturn := fake.rebootDBInstanceReturnsOnCall[len(fake.rebootDBInstanceArgsForCall)]
+	fake.rebootDBInstanceArgsForCall = append(fake.rebootDBInstanceArgsForCall, struct {
+		input *rds.RebootDBInstanceInput
+	}{input})
+	fake.recordInvocation("RebootDBInstance", []interface{}{input})
+	fake.rebootDBInstanceMutex.Unlock()
+	if fake.RebootDBInstanceStub != nil {
+		return fake.RebootDBInstanceStub(input)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.rebootDBInstanceReturns.result1, fake.rebootDBInstanceReturns.result2
+}
+
+func (fake *FakeRDSService) RebootDBInstanceCallCount() int {
+	fake.rebootDBInstanceMutex.RLock()
+	defer fake.rebootDBInstanceMutex.RUnlock()
+	return len(fake.rebootDBInstanceArgsForCall)
+}
+
+func (fake *FakeRDSService) RebootDBInstanceArgsForCall(i int) *rds.RebootDBInstanceInput {
+	fake.rebootDBInstanceMutex.RLock()
+	defer fake.rebootDBInstanceMutex.RUnlock()
+	return fake.rebootDBInstanceArgsForCall[i].input
+}
+
+func (fake *FakeRDSService) RebootDBInstanceReturns(result1 *rds.RebootDBInstanceOutput, result2 error) {
+	fake.RebootDBInstanceStub = nil
+	fake.rebootDBInstanceReturns = struct {
+		result1 *rds.RebootDBInstanceOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRDSService) RebootDBInstanceReturnsOnCall(i int, result1 *rds.RebootDBInstanceOutput, result2 error) {
+	fake.RebootDBInstanceStub = nil
+	if fake.rebootDBInstanceReturnsOnCall == nil {
+		fake.rebootDBInstanceReturnsOnCall = make(map[int]struct {
+			result1 *rds.RebootDBInstanceOutput
+			result2 error
+		})
+	}
+	fake.rebootDBInstanceReturnsOnCall[i] = struct {
+		result1 *rds.RebootDBInstanceOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRDSService) CreateDBCluster(input *rds.CreateDBClusterInput) (*rds.CreateDBClusterOutput, error) {
+	fake.createDBClusterMutex.Lock()
+	ret, specificReturn := fake.createDBClusterReturnsOnCall[len(fake.createDBClusterArgsForCall)]
+	fake.createDBClusterArgsForCall = append(fake.createDBClusterArgsForCall, struct {
+		input *rds.CreateDBClusterInput
+	}{input})
+	fake.recordInvocation("CreateDBCluster", []interface{}{input})
+	fake.createDBClusterMutex.Unlock()
+	if fake.CreateDBClusterStub != nil {
+		return fake.CreateDBClusterStub(input)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.createDBClusterReturns.result1, fake.createDBClusterReturns.result2
+}
+
+func (fake *FakeRDSService) CreateDBClusterCallCount() int {
+	fake.createDBClusterMutex.RLock()
+	defer fake.createDBClusterMutex.RUnlock()
+	return len(fake.createDBClusterArgsForCall)
+}
+
+func (fake *FakeRDSService) CreateDBClusterArgsForCall(i int) *rds.CreateDBClusterInput {
+	fake.createDBClusterMutex.RLock()
+	defer fake.createDBClusterMutex.RUnlock()
+	return fake.createDBClusterArgsForCall[i].input
+}
+
+func (fake *FakeRDSService) CreateDBClusterReturns(result1 *rds.CreateDBClusterOutput, result2 error) {
+	fake.CreateDBClusterStub = nil
+	fake.createDBClusterReturns = struct {
+		result1 *rds.CreateDBClusterOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRDSService) CreateDBClusterReturnsOnCall(i int, result1 *rds.CreateDBClusterOutput, result2 error) {
+	fake.CreateDBClusterStub = nil
+	if fake.createDBClusterReturnsOnCall == nil {
+		fake.createDBClusterReturnsOnCall = make(map[int]struct {
+			result1 *rds.CreateDBClusterOutput
+			result2 error
+		})
+	}
+	fake.createDBClusterReturnsOnCall[i] = struct {
+		result1 *rds.CreateDBClusterOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRDSService) DescribeDBClusters(input *rds.DescribeDBClustersInput) (*rds.DescribeDBClustersOutput, error) {
+	fake.describeDBClustersMutex.Lock()
+	ret, specificReturn := fake.describeDBClustersReturnsOnCall[len(fake.describeDBClustersArgsForCall)]
+	fake.describeDBClustersArgsForCall = append(fake.describeDBClustersArgsForCall, struct {
+		input *rds.DescribeDBClustersInput
+	}{input})
+	fake.recordInvocation("DescribeDBClusters", []interface{}{input})
+	fake.describeDBClustersMutex.Unlock()
+	if fake.DescribeDBClustersStub != nil {
+		return fake.DescribeDBClustersStub(input)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.describeDBClustersReturns.result1, fake.describeDBClustersReturns.result2
+}
+
+func (fake *FakeRDSService) DescribeDBClustersCallCount() int {
+	fake.describeDBClustersMutex.RLock()
+	defer fake.describeDBClustersMutex.RUnlock()
+	return len(fake.describeDBClustersArgsForCall)
+}
+
+func (fake *FakeRDSService) DescribeDBClustersArgsForCall(i int) *rds.DescribeDBClustersInput {
+	fake.describeDBClustersMutex.RLock()
+	defer fake.describeDBClustersMutex.RUnlock()
+	return fake.describeDBClustersArgsForCall[i].input
+}
+
+func (fake *FakeRDSService) DescribeDBClustersReturns(result1 *rds.DescribeDBClustersOutput, result2 error) {
+	fake.DescribeDBClustersStub = nil
+	fake.describeDBClustersReturns = struct {
+		result1 *rds.DescribeDBClustersOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRDSService) DescribeDBClustersReturnsOnCall(i int, result1 *rds.DescribeDBClustersOutput, result2 error) {
+	fake.DescribeDBClustersStub = nil
+	if fake.describeDBClustersReturnsOnCall == nil {
+		fake.describeDBClustersReturnsOnCall = make(map[int]struct {
+			result1 *rds.DescribeDBClustersOutput
+			result2 error
+		})
+	}
+	fake.describeDBClustersReturnsOnCall[i] = struct {
+		result1 *rds.DescribeDBClustersOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRDSService) ModifyDBCluster(input *rds.ModifyDBClusterInput) (*rds.ModifyDBClusterOutput, error) {
+	fake.modifyDBClusterMutex.Lock()
+	ret, specificReturn := fake.modifyDBClusterReturnsOnCall[len(fake.modifyDBClusterArgsForCall)]
+	fake.modifyDBClusterArgsForCall = append(fake.modifyDBClusterArgsForCall, struct {
+		input *rds.ModifyDBClusterInput
+	}{input})
+	fake.recordInvocation("ModifyDBCluster", []interface{}{input})
+	fake.modifyDBClusterMutex.Unlock()
+	if fake.ModifyDBClusterStub != nil {
+		return fake.ModifyDBClusterStub(input)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.modifyDBClusterReturns.result1, fake.modifyDBClusterReturns.result2
+}
+
+func (fake *FakeRDSService) ModifyDBClusterCallCount() int {
+	fake.modifyDBClusterMutex.RLock()
+	defer fake.modifyDBClusterMutex.RUnlock()
+	return len(fake.modifyDBClusterArgsForCall)
+}
+
+func (fake *FakeRDSService) ModifyDBClusterArgsForCall(i int) *rds.ModifyDBClusterInput {
+	fake.modifyDBClusterMutex.RLock()
+	defer fake.modifyDBClusterMutex.RUnlock()
+	return fake.modifyDBClusterArgsForCall[i].input
+}
+
+func (fake *FakeRDSService) ModifyDBClusterReturns(result1 *rds.ModifyDBClusterOutput, result2 error) {
+	fake.ModifyDBClusterStub = nil
+	fake.modifyDBClusterReturns = struct {
+		result1 *rds.ModifyDBClusterOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRDSService) ModifyDBClusterReturnsOnCall(i int, result1 *rds.ModifyDBClusterOutput, result2 error) {
+	fake.ModifyDBClusterStub = nil
+	if fake.modifyDBClusterReturnsOnCall == nil {
+		fake.modifyDBClusterReturnsOnCall = make(map[int]struct {
+			result1 *rds.ModifyDBClusterOutput
+			result2 error
+		})
+	}
+	fake.modifyDBClusterReturnsOnCall[i] = struct {
+		result1 *rds.ModifyDBClusterOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRDSService) DeleteDBCluster(input *rds.DeleteDBClusterInput) (*rds.DeleteDBClusterOutput, error) {
+	fake.deleteDBClusterMutex.Lock()
+	ret, specificReturn := fake.deleteDBClusterReturnsOnCall[len(fake.deleteDBClusterArgsForCall)]
+	fake.deleteDBClusterArgsForCall = append(fake.deleteDBClusterArgsForCall, struct {
+		input *rds.DeleteDBClusterInput
+	}{input})
+	fake.recordInvocation("DeleteDBCluster", []interface{}{input})
+	fake.deleteDBClusterMutex.Unlock()
+	if fake.DeleteDBClusterStub != nil {
+		return fake.DeleteDBClusterStub(input)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteDBClusterReturns.result1, fake.deleteDBClusterReturns.result2
+}
+
+func (fake *FakeRDSService) DeleteDBClusterCallCount() int {
+	fake.deleteDBClusterMutex.RLock()
+	defer fake.deleteDBClusterMutex.RUnlock()
+	return len(fake.deleteDBClusterArgsForCall)
+}
+
+func (fake *FakeRDSService) DeleteDBClusterArgsForCall(i int) *rds.DeleteDBClusterInput {
+	fake.deleteDBClusterMutex.RLock()
+	defer fake.deleteDBClusterMutex.RUnlock()
+	return fake.deleteDBClusterArgsForCall[i].input
+}
+
+func (fake *FakeRDSService) DeleteDBClusterReturns(result1 *rds.DeleteDBClusterOutput, result2 error) {
+	fake.DeleteDBClusterStub = nil
+	fake.deleteDBClusterReturns = struct {
+		result1 *rds.DeleteDBClusterOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRDSService) DeleteDBClusterReturnsOnCall(i int, result1 *rds.DeleteDBClusterOutput, result2 error) {
+	fake.DeleteDBClusterStub = nil
+	if fake.deleteDBClusterReturnsOnCall == nil {
+		fake.deleteDBClusterReturnsOnCall = make(map[int]struct {
+			result1 *rds.DeleteDBClusterOutput
+			result2 error
+		})
+	}
+	fake.deleteDBClusterReturnsOnCall[i] = struct {
+		result1 *rds.DeleteDBClusterOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRDSService) DescribeDBClusterSnapshots(input *rds.DescribeDBClusterSnapshotsInput) (*rds.DescribeDBClusterSnapshotsOutput, error) {
+	fake.describeDBClusterSnapshotsMutex.Lock()
+	ret, specificReturn := fake.describeDBClusterSnapshotsReturnsOnCall[len(fake.describeDBClusterSnapshotsArgsForCall)]
+	fake.describeDBClusterSnapshotsArgsForCall = append(fake.describeDBClusterSnapshotsArgsForCall, struct {
+		input *rds.DescribeDBClusterSnapshotsInput
+	}{input})
+	fake.recordInvocation("DescribeDBClusterSnapshots", []interface{}{input})
+	fake.describeDBClusterSnapshotsMutex.Unlock()
+	if fake.DescribeDBClusterSnapshotsStub != nil {
+		return fake.DescribeDBClusterSnapshotsStub(input)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.describeDBClusterSnapshotsReturns.result1, fake.describeDBClusterSnapshotsReturns.result2
+}
+
+func (fake *FakeRDSService) DescribeDBClusterSnapshotsCallCount() int {
+	fake.describeDBClusterSnapshotsMutex.RLock()
+	defer fake.describeDBClusterSnapshotsMutex.RUnlock()
+	return len(fake.describeDBClusterSnapshotsArgsForCall)
+}
+
+func (fake *FakeRDSService) DescribeDBClusterSnapshotsArgsForCall(i int) *rds.DescribeDBClusterSnapshotsInput {
+	fake.describeDBClusterSnapshotsMutex.RLock()
+	defer fake.describeDBClusterSnapshotsMutex.RUnlock()
+	return fake.describeDBClusterSnapshotsArgsForCall[i].input
+}
+
+func (fake *FakeRDSService) DescribeDBClusterSnapshotsReturns(result1 *rds.DescribeDBClusterSnapshotsOutput, result2 error) {
+	fake.DescribeDBClusterSnapshotsStub = nil
+	fake.describeDBClusterSnapshotsReturns = struct {
+		result1 *rds.DescribeDBClusterSnapshotsOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRDSService) DescribeDBClusterSnapshotsReturnsOnCall(i int, result1 *rds.DescribeDBClusterSnapshotsOutput, result2 error) {
+	fake.DescribeDBClusterSnapshotsStub = nil
+	if fake.describeDBClusterSnapshotsReturnsOnCall == nil {
+		fake.describeDBClusterSnapshotsReturnsOnCall = make(map[int]struct {
+			result1 *rds.DescribeDBClusterSnapshotsOutput
+			result2 error
+		})
+	}
+	fake.describeDBClusterSnapshotsReturnsOnCall[i] = struct {
+		result1 *rds.DescribeDBClusterSnapshotsOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRDSService) CreateDBInstanceReadReplica(input *rds.CreateDBInstanceReadReplicaInput) (*rds.CreateDBInstanceReadReplicaOutput, error) {
+	fake.createDBInstanceReadReplicaMutex.Lock()
+	ret, specificReturn := fake.createDBInstanceReadReplicaReturnsOnCall[len(fake.createDBInstanceReadReplicaArgsForCall)]
+	fake.createDBInstanceReadReplicaArgsForCall = append(fake.createDBInstanceReadReplicaArgsForCall, struct {
+		input *rds.CreateDBInstanceReadReplicaInput
+	}{input})
+	fake.recordInvocation("CreateDBInstanceReadReplica", []interface{}{input})
+	fake.createDBInstanceReadReplicaMutex.Unlock()
+	if fake.CreateDBInstanceReadReplicaStub != nil {
+		return fake.CreateDBInstanceReadReplicaStub(input)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.createDBInstanceReadReplicaReturns.result1, fake.createDBInstanceReadReplicaReturns.result2
+}
+
+func (fake *FakeRDSService) CreateDBInstanceReadReplicaCallCount() int {
+	fake.createDBInstanceReadReplicaMutex.RLock()
+	defer fake.createDBInstanceReadReplicaMutex.RUnlock()
+	return len(fake.createDBInstanceReadReplicaArgsForCall)
+}
+
+func (fake *FakeRDSService) CreateDBInstanceReadReplicaArgsForCall(i int) *rds.CreateDBInstanceReadReplicaInput {
+	fake.createDBInstanceReadReplicaMutex.RLock()
+	defer fake.createDBInstanceReadReplicaMutex.RUnlock()
+	return fake.createDBInstanceReadReplicaArgsForCall[i].input
+}
+
+func (fake *FakeRDSService) CreateDBInstanceReadReplicaReturns(result1 *rds.CreateDBInstanceReadReplicaOutput, result2 error) {
+	fake.CreateDBInstanceReadReplicaStub = nil
+	fake.createDBInstanceReadReplicaReturns = struct {
+		result1 *rds.CreateDBInstanceReadReplicaOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRDSService) CreateDBInstanceReadReplicaReturnsOnCall(i int, result1 *rds.CreateDBInstanceReadReplicaOutput, result2 error) {
+	fake.CreateDBInstanceReadReplicaStub = nil
+	if fake.createDBInstanceReadReplicaReturnsOnCall == nil {
+		fake.createDBInstanceReadReplicaReturnsOnCall = make(map[int]struct {
+			result1 *rds.CreateDBInstanceReadReplicaOutput
+			result2 error
+		})
+	}
+	fake.createDBInstanceReadReplicaReturnsOnCall[i] = struct {
+		result1 *rds.CreateDBInstanceReadReplicaOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRDSService) PromoteReadReplica(input *rds.PromoteReadReplicaInput) (*rds.PromoteReadReplicaOutput, error) {
+	fake.promoteReadReplicaMutex.Lock()
+	ret, specificReturn := fake.promoteReadReplicaReturnsOnCall[len(fake.promoteReadReplicaArgsForCall)]
+	fake.promoteReadReplicaArgsForCall = append(fake.promoteReadReplicaArgsForCall, struct {
+		input *rds.PromoteReadReplicaInput
+	}{input})
+	fake.recordInvocation("PromoteReadReplica", []interface{}{input})
+	fake.promoteReadReplicaMutex.Unlock()
+	if fake.PromoteReadReplicaStub != nil {
+		return fake.PromoteReadReplicaStub(input)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.promoteReadReplicaReturns.result1, fake.promoteReadReplicaReturns.result2
+}
+
+func (fake *FakeRDSService) PromoteReadReplicaCallCount() int {
+	fake.promoteReadReplicaMutex.RLock()
+	defer fake.promoteReadReplicaMutex.RUnlock()
+	return len(fake.promoteReadReplicaArgsForCall)
+}
+
+func (fake *FakeRDSService) PromoteReadReplicaArgsForCall(i int) *rds.PromoteReadReplicaInput {
+	fake.promoteReadReplicaMutex.RLock()
+	defer fake.promoteReadReplicaMutex.RUnlock()
+	return fake.promoteReadReplicaArgsForCall[i].input
+}
+
+func (fake *FakeRDSService) PromoteReadReplicaReturns(result1 *rds.PromoteReadReplicaOutput, result2 error) {
+	fake.PromoteReadReplicaStub = nil
+	fake.promoteReadReplicaReturns = struct {
+		result1 *rds.PromoteReadReplicaOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRDSService) PromoteReadReplicaReturnsOnCall(i int, result1 *rds.PromoteReadReplicaOutput, result2 error) {
+	fake.PromoteReadReplicaStub = nil
+	if fake.promoteReadReplicaReturnsOnCall == nil {
+		fake.promoteReadReplicaReturnsOnCall = make(map[int]struct {
+			result1 *rds.PromoteReadReplicaOutput
+			result2 error
+		})
+	}
+	fake.promoteReadReplicaReturnsOnCall[i] = struct {
+		result1 *rds.PromoteReadReplicaOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRDSService) DescribeDBEngineVersions(input *rds.DescribeDBEngineVersionsInput) (*rds.DescribeDBEngineVersionsOutput, error) {
+	fake.describeDBEngineVersionsMutex.Lock()
+	ret, specificReturn := fake.describeDBEngineVersionsReturnsOnCall[len(fake.describeDBEngineVersionsArgsForCall)]
+	fake.describeDBEngineVersionsArgsForCall = append(fake.describeDBEngineVersionsArgsForCall, struct {
+		input *rds.DescribeDBEngineVersionsInput
+	}{input})
+	fake.recordInvocation("DescribeDBEngineVersions", []interface{}{input})
+	fake.describeDBEngineVersionsMutex.Unlock()
+	if fake.DescribeDBEngineVersionsStub != nil {
+		return fake.DescribeDBEngineVersionsStub(input)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.describeDBEngineVersionsReturns.result1, fake.describeDBEngineVersionsReturns.result2
+}
+
+func (fake *FakeRDSService) DescribeDBEngineVersionsCallCount() int {
+	fake.describeDBEngineVersionsMutex.RLock()
+	defer fake.describeDBEngineVersionsMutex.RUnlock()
+	return len(fake.describeDBEngineVersionsArgsForCall)
+}
+
+func (fake *FakeRDSService) DescribeDBEngineVersionsArgsForCall(i int) *rds.DescribeDBEngineVersionsInput {
+	fake.describeDBEngineVersionsMutex.RLock()
+	defer fake.describeDBEngineVersionsMutex.RUnlock()
+	return fake.describeDBEngineVersionsArgsForCall[i].input
+}
+
+func (fake *FakeRDSService) DescribeDBEngineVersionsReturns(result1 *rds.DescribeDBEngineVersionsOutput, result2 error) {
+	fake.DescribeDBEngineVersionsStub = nil
+	fake.describeDBEngineVersionsReturns = struct {
+		result1 *rds.DescribeDBEngineVersionsOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRDSService) DescribeDBEngineVersionsReturnsOnCall(i int, result1 *rds.DescribeDBEngineVersionsOutput, result2 error) {
+	fake.DescribeDBEngineVersionsStub = nil
+	if fake.describeDBEngineVersionsReturnsOnCall == nil {
+		fake.describeDBEngineVersionsReturnsOnCall = make(map[int]struct {
+			result1 *rds.DescribeDBEngineVersionsOutput
+			result2 error
+		})
+	}
+	fake.describeDBEngineVersionsReturnsOnCall[i] = struct {
+		result1 *rds.DescribeDBEngineVersionsOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRDSService) CreateDBParameterGroup(input *rds.CreateDBParameterGroupInput) (*rds.CreateDBParameterGroupOutput, error) {
+	fake.createDBParameterGroupMutex.Lock()
+	ret, specificReturn := fake.createDBParameterGroupReturnsOnCall[len(fake.createDBParameterGroupArgsForCall)]
+	fake.createDBParameterGroupArgsForCall = append(fake.createDBParameterGroupArgsForCall, struct {
+		input *rds.CreateDBParameterGroupInput
+	}{input})
+	fake.recordInvocation("CreateDBParameterGroup", []interface{}{input})
+	fake.createDBParameterGroupMutex.Unlock()
+	if fake.CreateDBParameterGroupStub != nil {
+		return fake.CreateDBParameterGroupStub(input)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.createDBParameterGroupReturns.result1, fake.createDBParameterGroupReturns.result2
+}
+
+func (fake *FakeRDSService) CreateDBParameterGroupCallCount() int {
+	fake.createDBParameterGroupMutex.RLock()
+	defer fake.createDBParameterGroupMutex.RUnlock()
+	return len(fake.createDBParameterGroupArgsForCall)
+}
+
+func (fake *FakeRDSService) CreateDBParameterGroupArgsForCall(i int) *rds.CreateDBParameterGroupInput {
+	fake.createDBParameterGroupMutex.RLock()
+	defer fake.createDBParameterGroupMutex.RUnlock()
+	return fake.createDBParameterGroupArgsForCall[i].input
+}
+
+func (fake *FakeRDSService) CreateDBParameterGroupReturns(result1 *rds.CreateDBParameterGroupOutput, result2 error) {
+	fake.CreateDBParameterGroupStub = nil
+	fake.createDBParameterGroupReturns = struct {
+		result1 *rds.CreateDBParameterGroupOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRDSService) CreateDBParameterGroupReturnsOnCall(i int, result1 *rds.CreateDBParameterGroupOutput, result2 error) {
+	fake.CreateDBParameterGroupStub = nil
+	if fake.createDBParameterGroupReturnsOnCall == nil {
+		fake.createDBParameterGroupReturnsOnCall = make(map[int]struct {
+			result1 *rds.CreateDBParameterGroupOutput
+			result2 error
+		})
+	}
+	fake.createDBParameterGroupReturnsOnCall[i] = struct {
+		result1 *rds.CreateDBParameterGroupOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRDSService) DescribeDBParametersPages(input *rds.DescribeDBParametersInput, fn func(*rds.DescribeDBParametersOutput, bool) bool) error {
+	fake.describeDBParametersPagesMutex.Lock()
+	ret, specificReturn := fake.describeDBParametersPagesReturnsOnCall[len(fake.describeDBParametersPagesArgsForCall)]
+	fake.describeDBParametersPagesArgsForCall = append(fake.describeDBParametersPagesArgsForCall, struct {
+		input *rds.DescribeDBParametersInput
+		fn    func(*rds.DescribeDBParametersOutput, bool) bool
+	}{input, fn})
+	fake.recordInvocation("DescribeDBParametersPages", []interface{}{input, fn})
+	fake.describeDBParametersPagesMutex.Unlock()
+	if fake.DescribeDBParametersPagesStub != nil {
+		return fake.DescribeDBParametersPagesStub(input, fn)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.describeDBParametersPagesReturns.result1
+}
+
+func (fake *FakeRDSService) DescribeDBParametersPagesCallCount() int {
+	fake.describeDBParametersPagesMutex.RLock()
+	defer fake.describeDBParametersPagesMutex.RUnlock()
+	return len(fake.describeDBParametersPagesArgsForCall)
+}
+
+func (fake *FakeRDSService) DescribeDBParametersPagesArgsForCall(i int) (*rds.DescribeDBParametersInput, func(*rds.DescribeDBParametersOutput, bool) bool) {
+	fake.describeDBParametersPagesMutex.RLock()
+	defer fake.describeDBParametersPagesMutex.RUnlock()
+	return fake.describeDBParametersPagesArgsForCall[i].input, fake.describeDBParametersPagesArgsForCall[i].fn
+}
+
+func (fake *FakeRDSService) DescribeDBParametersPagesReturns(result1 error) {
+	fake.DescribeDBParametersPagesStub = nil
+	fake.describeDBParametersPagesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRDSService) DescribeDBParametersPagesReturnsOnCall(i int, result1 error) {
+	fake.DescribeDBParametersPagesStub = nil
+	if fake.describeDBParametersPagesReturnsOnCall == nil {
+		fake.describeDBParametersPagesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.describeDBParametersPagesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRDSService) ModifyDBParameterGroup(input *rds.ModifyDBParameterGroupInput) (*rds.DBParameterGroupNameMessage, error) {
+	fake.modifyDBParameterGroupMutex.Lock()
+	ret, specificReturn := fake.modifyDBParameterGroupReturnsOnCall[len(fake.modifyDBParameterGroupArgsForCall)]
+	fake.modifyDBParameterGroupArgsForCall = append(fake.modifyDBParameterGroupArgsForCall, struct {
+		input *rds.ModifyDBParameterGroupInput
+	}{input})
+	fake.recordInvocation("ModifyDBParameterGroup", []interface{}{input})
+	fake.modifyDBParameterGroupMutex.Unlock()
+	if fake.ModifyDBParameterGroupStub != nil {
+		return fake.ModifyDBParameterGroupStub(input)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.modifyDBParameterGroupReturns.result1, fake.modifyDBParameterGroupReturns.result2
+}
+
+func (fake *FakeRDSService) ModifyDBParameterGroupCallCount() int {
+	fake.modifyDBParameterGroupMutex.RLock()
+	defer fake.modifyDBParameterGroupMutex.RUnlock()
+	return len(fake.modifyDBParameterGroupArgsForCall)
+}
+
+func (fake *FakeRDSService) ModifyDBParameterGroupArgsForCall(i int) *rds.ModifyDBParameterGroupInput {
+	fake.modifyDBParameterGroupMutex.RLock()
+	defer fake.modifyDBParameterGroupMutex.RUnlock()
+	return fake.modifyDBParameterGroupArgsForCall[i].input
+}
+
+func (fake *FakeRDSService) ModifyDBParameterGroupReturns(result1 *rds.DBParameterGroupNameMessage, result2 error) {
+	fake.ModifyDBParameterGroupStub = nil
+	fake.modifyDBParameterGroupReturns = struct {
+		result1 *rds.DBParameterGroupNameMessage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRDSService) ModifyDBParameterGroupReturnsOnCall(i int, result1 *rds.DBParameterGroupNameMessage, result2 error) {
+	fake.ModifyDBParameterGroupStub = nil
+	if fake.modifyDBParameterGroupReturnsOnCall == nil {
+		fake.modifyDBParameterGroupReturnsOnCall = make(map[int]struct {
+			result1 *rds.DBParameterGroupNameMessage
+			result2 error
+		})
+	}
+	fake.modifyDBParameterGroupReturnsOnCall[i] = struct {
+		result1 *rds.DBParameterGroupNameMessage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRDSService) DeleteDBParameterGroup(input *rds.DeleteDBParameterGroupInput) (*rds.DeleteDBParameterGroupOutput, error) {
+	fake.deleteDBParameterGroupMutex.Lock()
+	ret, specificReturn := fake.deleteDBParameterGroupReturnsOnCall[len(fake.deleteDBParameterGroupArgsForCall)]
+	fake.deleteDBParameterGroupArgsForCall = append(fake.deleteDBParameterGroupArgsForCall, struct {
+		input *rds.DeleteDBParameterGroupInput
+	}{input})
+	fake.recordInvocation("DeleteDBParameterGroup", []interface{}{input})
+	fake.deleteDBParameterGroupMutex.Unlock()
+	if fake.DeleteDBParameterGroupStub != nil {
+		return fake.DeleteDBParameterGroupStub(input)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteDBParameterGroupReturns.result1, fake.deleteDBParameterGroupReturns.result2
+}
+
+func (fake *FakeRDSService) DeleteDBParameterGroupCallCount() int {
+	fake.deleteDBParameterGroupMutex.RLock()
+	defer fake.deleteDBParameterGroupMutex.RUnlock()
+	return len(fake.deleteDBParameterGroupArgsForCall)
+}
+
+func (fake *FakeRDSService) DeleteDBParameterGroupArgsForCall(i int) *rds.DeleteDBParameterGroupInput {
+	fake.deleteDBParameterGroupMutex.RLock()
+	defer fake.deleteDBParameterGroupMutex.RUnlock()
+	return fake.deleteDBParameterGroupArgsForCall[i].input
+}
+
+func (fake *FakeRDSService) DeleteDBParameterGroupReturns(result1 *rds.DeleteDBParameterGroupOutput, result2 error) {
+	fake.DeleteDBParameterGroupStub = nil
+	fake.deleteDBParameterGroupReturns = struct {
+		result1 *rds.DeleteDBParameterGroupOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRDSService) DeleteDBParameterGroupReturnsOnCall(i int, result1 *rds.DeleteDBParameterGroupOutput, result2 error) {
+	fake.DeleteDBParameterGroupStub = nil
+	if fake.deleteDBParameterGroupReturnsOnCall == nil {
+		fake.deleteDBParameterGroupReturnsOnCall = make(map[int]struct {
+			result1 *rds.DeleteDBParameterGroupOutput
+			result2 error
+		})
+	}
+	fake.deleteDBParameterGroupReturnsOnCall[i] = struct {
+		result1 *rds.DeleteDBParameterGroupOutput
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeRDSService) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
-	fake.describeDBSubnetGroupsMutex.RLock()
-	defer fake.describeDBSubnetGroupsMutex.RUnlock()
+	fake.describeDBSubnetGroupsPagesMutex.RLock()
+	defer fake.describeDBSubnetGroupsPagesMutex.RUnlock()
+	fake.listTagsForResourceMutex.RLock()
+	defer fake.listTagsForResourceMutex.RUnlock()
 	fake.createDBInstanceMutex.RLock()
 	defer fake.createDBInstanceMutex.RUnlock()
 	fake.describeDBInstancesMutex.RLock()
 	defer fake.describeDBInstancesMutex.RUnlock()
 	fake.modifyDBInstanceMutex.RLock()
 	defer fake.modifyDBInstanceMutex.RUnlock()
-	fake.waitUntilDBInstanceAvailableMutex.RLock()
-	defer fake.waitUntilDBInstanceAvailableMutex.RUnlock()
+	fake.waitUntilDBInstanceAvailableWithContextMutex.RLock()
+	defer fake.waitUntilDBInstanceAvailableWithContextMutex.RUnlock()
+	fake.deleteDBInstanceMutex.RLock()
+	defer fake.deleteDBInstanceMutex.RUnlock()
+	fake.waitUntilDBInstanceDeletedWithContextMutex.RLock()
+	defer fake.waitUntilDBInstanceDeletedWithContextMutex.RUnlock()
+	fake.createDBSnapshotMutex.RLock()
+	defer fake.createDBSnapshotMutex.RUnlock()
+	fake.describeDBSnapshotsMutex.RLock()
+	defer fake.describeDBSnapshotsMutex.RUnlock()
+	fake.deleteDBSnapshotMutex.RLock()
+	defer fake.deleteDBSnapshotMutex.RUnlock()
+	fake.restoreDBInstanceFromDBSnapshotMutex.RLock()
+	defer fake.restoreDBInstanceFromDBSnapshotMutex.RUnlock()
+	fake.restoreDBInstanceToPointInTimeMutex.RLock()
+	defer fake.restoreDBInstanceToPointInTimeMutex.RUnlock()
+	fake.stopDBInstanceMutex.RLock()
+	defer fake.stopDBInstanceMutex.RUnlock()
+	fake.startDBInstanceMutex.RLock()
+	defer fake.startDBInstanceMutex.RUnlock()
+	fake.rebootDBInstanceMutex.RLock()
+	defer fake.rebootDBInstanceMutex.RUnlock()
+	fake.createDBClusterMutex.RLock()
+	defer fake.createDBClusterMutex.RUnlock()
+	fake.describeDBClustersMutex.RLock()
+	defer fake.describeDBClustersMutex.RUnlock()
+	fake.modifyDBClusterMutex.RLock()
+	defer fake.modifyDBClusterMutex.RUnlock()
+	fake.deleteDBClusterMutex.RLock()
+	defer fake.deleteDBClusterMutex.RUnlock()
+	fake.describeDBClusterSnapshotsMutex.RLock()
+	defer fake.describeDBClusterSnapshotsMutex.RUnlock()
+	fake.createDBInstanceReadReplicaMutex.RLock()
+	defer fake.createDBInstanceReadReplicaMutex.RUnlock()
+	fake.promoteReadReplicaMutex.RLock()
+	defer fake.promoteReadReplicaMutex.RUnlock()
+	fake.describeDBEngineVersionsMutex.RLock()
+	defer fake.describeDBEngineVersionsMutex.RUnlock()
+	fake.createDBParameterGroupMutex.RLock()
+	defer fake.createDBParameterGroupMutex.RUnlock()
+	fake.describeDBParametersPagesMutex.RLock()
+	defer fake.describeDBParametersPagesMutex.RUnlock()
+	fake.modifyDBParameterGroupMutex.RLock()
+	defer fake.modifyDBParameterGroupMutex.RUnlock()
+	fake.deleteDBParameterGroupMutex.RLock()
+	defer fake.deleteDBParameterGroupMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
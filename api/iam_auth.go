@@ -0,0 +1,238 @@
+package api
+
+import (
+	"context"
+	"crypto/x509"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/rds/rdsutils"
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
+)
+
+// IAMUsername is the DB user apps log in as with IAM auth tokens.
+const IAMUsername = "app"
+
+// IAMRole is the PostgreSQL role of the users that log in with IAM auth
+// tokens.
+const IAMRole = "rds_iam"
+
+type IAMService interface {
+	PutRolePolicy(input *iam.PutRolePolicyInput) (*iam.PutRolePolicyOutput, error)
+	DeleteRolePolicy(input *iam.DeleteRolePolicyInput) (*iam.DeleteRolePolicyOutput, error)
+}
+
+// DB runs statements on a database, like *sql.DB does.
+type DB interface {
+	PingContext(ctx context.Context) error
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	Close() error
+}
+
+// OpenDB connects to a database with a database/sql driver.
+var OpenDB = func(driverName string, dataSourceName string) (DB, error) {
+	return sql.Open(driverName, dataSourceName)
+}
+
+// RDSCABundleURL is where the certificates of the CAs that sign the server
+// certificates of RDS instances are published.
+const RDSCABundleURL = "https://truststore.pki.rds.amazonaws.com/global/global-bundle.pem"
+
+// FetchRDSCABundle downloads the RDS CA certificates in PEM format, giving up
+// when ctx is done.
+var FetchRDSCABundle = func(ctx context.Context) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, RDSCABundleURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Error: could not download the RDS CA certificates from %s: %s", RDSCABundleURL, resp.Status)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+// writeCABundle writes the RDS CA certificates to a temporary file, which the
+// caller removes, as the PostgreSQL driver only reads them from a file.
+func writeCABundle(ctx context.Context) (string, error) {
+	bundle, err := FetchRDSCABundle(ctx)
+	if err != nil {
+		return "", err
+	}
+	if !x509.NewCertPool().AppendCertsFromPEM(bundle) {
+		return "", fmt.Errorf("Error: found no certificates in the RDS CA certificates from %s", RDSCABundleURL)
+	}
+
+	file, err := ioutil.TempFile("", "rds-ca-bundle")
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	_, err = file.Write(bundle)
+	if err != nil {
+		os.Remove(file.Name())
+		return "", err
+	}
+	return file.Name(), nil
+}
+
+// readCertPool reads the CA certificates in the PEM file.
+func readCertPool(caFile string) (*x509.CertPool, error) {
+	bundle, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(bundle) {
+		return nil, fmt.Errorf("Error: found no certificates in %s", caFile)
+	}
+	return pool, nil
+}
+
+// SetUpIAMAuth creates the DB user apps log in as with IAM auth tokens,
+// connecting as the master user over TLS verified against the RDS CA
+// certificates, and allows the IAM role to connect as that
+// user. The instance then holds the credentials of that user instead of the
+// master ones, which are not kept anywhere. It gives up when ctx is done.
+func (f *CfRDSApi) SetUpIAMAuth(ctx context.Context, instance *DBInstance, roleARN string) error {
+	engine, err := LookupEngine(instance.Engine)
+	if err != nil {
+		return err
+	}
+	if engine.IAMAuth == nil {
+		return fmt.Errorf("Error: RDS engine %s does not support IAM database authentication", instance.Engine)
+	}
+
+	caFile, err := writeCABundle(ctx)
+	if err != nil {
+		return contextError(ctx, err)
+	}
+	defer os.Remove(caFile)
+
+	dataSourceName, err := engine.IAMAuth.DataSourceName(instance.Address, instance.Port, instance.Username, instance.Password, instance.DBName, caFile)
+	if err != nil {
+		return err
+	}
+	db, err := OpenDB(engine.IAMAuth.Driver, dataSourceName)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	err = db.PingContext(ctx)
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return fmt.Errorf("Error: could not connect to RDS instance %s as the master user: %s", instance.InstanceName, err)
+	}
+	for _, statement := range engine.IAMAuth.CreateUser(IAMUsername, instance.DBName) {
+		_, err = db.ExecContext(ctx, statement)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return fmt.Errorf("Error: could not create DB user %s on RDS instance %s: %s", IAMUsername, instance.InstanceName, err)
+		}
+	}
+
+	policy, err := connectPolicy(instance.ARN, instance.ResourceID)
+	if err != nil {
+		return err
+	}
+	_, err = f.IAM.PutRolePolicy(&iam.PutRolePolicyInput{
+		RoleName:       aws.String(RoleName(roleARN)),
+		PolicyName:     aws.String(rolePolicyName(instance.InstanceName)),
+		PolicyDocument: aws.String(policy),
+	})
+	if err != nil {
+		return credentialsError(err)
+	}
+
+	instance.Username = IAMUsername
+	instance.Password = ""
+	instance.DBURI = ""
+	instance.JDBCURI = ""
+	instance.ReadURI = ""
+	instance.Region = ARNRegion(instance.ARN)
+	instance.RoleARN = roleARN
+	return nil
+}
+
+// RevokeIAMAuth deletes the policy that allows the IAM role to connect to
+// the RDS instance, if there is one.
+func (f *CfRDSApi) RevokeIAMAuth(instanceName string, roleARN string) error {
+	_, err := f.IAM.DeleteRolePolicy(&iam.DeleteRolePolicyInput{
+		RoleName:   aws.String(RoleName(roleARN)),
+		PolicyName: aws.String(rolePolicyName(instanceName)),
+	})
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == iam.ErrCodeNoSuchEntityException {
+		return nil
+	}
+	if err != nil {
+		return credentialsError(err)
+	}
+	return nil
+}
+
+// AuthToken generates an IAM auth token for the DB user of the credentials,
+// signed with the AWS credentials of the plugin. Tokens expire after 15
+// minutes.
+func (f *CfRDSApi) AuthToken(credentials *DBInstance) (string, error) {
+	token, err := rdsutils.BuildAuthToken(fmt.Sprintf("%s:%d", credentials.Address, credentials.Port), credentials.Region, credentials.Username, f.Credentials)
+	if err != nil {
+		return "", credentialsError(err)
+	}
+	return token, nil
+}
+
+// RoleName returns the name of the IAM role with the ARN, e.g. app for
+// arn:aws:iam::123456789012:role/path/app.
+func RoleName(roleARN string) string {
+	return roleARN[strings.LastIndex(roleARN, "/")+1:]
+}
+
+// rolePolicyName returns the name of the inline policy that allows an IAM
+// role to connect to the RDS instance.
+func rolePolicyName(instanceName string) string {
+	return "cf-rds-" + instanceName
+}
+
+// connectPolicy returns the policy document that allows connecting as
+// IAMUsername to the RDS instance or DB cluster with the ARN and resource ID.
+func connectPolicy(arn string, resourceID string) (string, error) {
+	parts := strings.Split(arn, ":")
+	if len(parts) < 6 || parts[0] != "arn" {
+		return "", fmt.Errorf("Error: %s is not the ARN of an RDS instance", arn)
+	}
+
+	type statement struct {
+		Effect   string
+		Action   string
+		Resource string
+	}
+	policy, err := json.Marshal(struct {
+		Version   string
+		Statement []statement
+	}{
+		Version: "2012-10-17",
+		Statement: []statement{{
+			Effect:   "Allow",
+			Action:   "rds-db:connect",
+			Resource: fmt.Sprintf("arn:%s:rds-db:%s:%s:dbuser:%s/%s", parts[1], parts[3], parts[4], resourceID, IAMUsername),
+		}},
+	})
+	return string(policy), err
+}
@@ -0,0 +1,86 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+)
+
+// StopInstance stops the RDS instance and waits until it is stopped.
+func (f *CfRDSApi) StopInstance(ctx context.Context, instanceName string) error {
+	_, err := f.Svc.StopDBInstance(&rds.StopDBInstanceInput{
+		DBInstanceIdentifier: aws.String(instanceName),
+	})
+	if err != nil {
+		return credentialsError(err)
+	}
+
+	_, err = f.waitForStatus(ctx, instanceName, "stopped")
+	return err
+}
+
+// StartInstance starts the stopped RDS instance and waits until it is
+// available.
+func (f *CfRDSApi) StartInstance(ctx context.Context, instanceName string) error {
+	_, err := f.Svc.StartDBInstance(&rds.StartDBInstanceInput{
+		DBInstanceIdentifier: aws.String(instanceName),
+	})
+	if err != nil {
+		return credentialsError(err)
+	}
+
+	_, err = f.waitForStatus(ctx, instanceName, "available")
+	return err
+}
+
+// RebootInstance reboots the RDS instance and waits until it is available.
+// With forceFailover a Multi-AZ instance fails over to its standby. RDS may
+// still report the instance as available right after accepting the reboot,
+// so it first waits for the reboot to start.
+func (f *CfRDSApi) RebootInstance(ctx context.Context, instanceName string, forceFailover bool) error {
+	input := &rds.RebootDBInstanceInput{
+		DBInstanceIdentifier: aws.String(instanceName),
+	}
+	if forceFailover {
+		input.ForceFailover = aws.Bool(true)
+	}
+
+	_, err := f.Svc.RebootDBInstance(input)
+	if err != nil {
+		return credentialsError(err)
+	}
+
+	err = f.waitToLeaveStatus(ctx, instanceName, "available")
+	if err != nil {
+		return err
+	}
+	_, err = f.waitForStatus(ctx, instanceName, "available")
+	return err
+}
+
+// waitToLeaveStatus polls the instance until it reports another status than
+// the given one. Like waitForStatus it gives up once the instance is in one of
+// the failedStatuses.
+func (f *CfRDSApi) waitToLeaveStatus(ctx context.Context, instanceName string, status string) error {
+	for {
+		current, err := f.DescribeInstance(instanceName)
+		if err != nil {
+			return err
+		}
+		reportInstanceProgress(ctx, current)
+
+		if failedStatuses[current.Status] {
+			return fmt.Errorf("Error: RDS instance %s is %s", instanceName, current.Status)
+		}
+		if current.Status != status {
+			return nil
+		}
+
+		err = f.pause(ctx)
+		if err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package api_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/seattle-beach/cf-cli-rds-plugin/api"
+	"github.com/seattle-beach/cf-cli-rds-plugin/api/fakes"
+)
+
+var _ = Describe("Lifecycle", func() {
+	var fakeRDSSvc *fakes.FakeRDSService
+	var cfRDSApi *api.CfRDSApi
+
+	withStatus := func(status string) *rds.DescribeDBInstancesOutput {
+		return &rds.DescribeDBInstancesOutput{
+			DBInstances: []*rds.DBInstance{{
+				DBInstanceIdentifier: aws.String("name"),
+				DBInstanceStatus:     aws.String(status),
+			}},
+		}
+	}
+
+	BeforeEach(func() {
+		fakeRDSSvc = &fakes.FakeRDSService{}
+		cfRDSApi = &api.CfRDSApi{
+			Svc:          fakeRDSSvc,
+			PollInterval: time.Millisecond,
+		}
+	})
+
+	Describe("StopInstance", func() {
+		It("stops the instance and polls until it is stopped", func() {
+			fakeRDSSvc.DescribeDBInstancesReturnsOnCall(0, withStatus("stopping"), nil)
+			fakeRDSSvc.DescribeDBInstancesReturnsOnCall(1, withStatus("stopped"), nil)
+
+			err := cfRDSApi.StopInstance(context.Background(), "name")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeRDSSvc.StopDBInstanceArgsForCall(0)).To(Equal(&rds.StopDBInstanceInput{
+				DBInstanceIdentifier: aws.String("name"),
+			}))
+			Expect(fakeRDSSvc.DescribeDBInstancesCallCount()).To(Equal(2))
+		})
+
+		It("gives up polling once the context is done", func() {
+			fakeRDSSvc.DescribeDBInstancesReturns(withStatus("stopping"), nil)
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+
+			err := cfRDSApi.StopInstance(ctx, "name")
+			Expect(err).To(Equal(context.DeadlineExceeded))
+		})
+	})
+
+	Describe("StartInstance", func() {
+		It("starts the instance and polls until it is available", func() {
+			fakeRDSSvc.DescribeDBInstancesReturnsOnCall(0, withStatus("starting"), nil)
+			fakeRDSSvc.DescribeDBInstancesReturnsOnCall(1, withStatus("available"), nil)
+
+			err := cfRDSApi.StartInstance(context.Background(), "name")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeRDSSvc.StartDBInstanceCallCount()).To(Equal(1))
+			Expect(fakeRDSSvc.DescribeDBInstancesCallCount()).To(Equal(2))
+		})
+
+		It("stops polling once the instance cannot become available", func() {
+			fakeRDSSvc.DescribeDBInstancesReturnsOnCall(0, withStatus("starting"), nil)
+			fakeRDSSvc.DescribeDBInstancesReturnsOnCall(1, withStatus("incompatible-network"), nil)
+
+			err := cfRDSApi.StartInstance(context.Background(), "name")
+			Expect(err).To(MatchError("Error: RDS instance name is incompatible-network"))
+			Expect(fakeRDSSvc.DescribeDBInstancesCallCount()).To(Equal(2))
+		})
+	})
+
+	Describe("RebootInstance", func() {
+		BeforeEach(func() {
+			fakeRDSSvc.DescribeDBInstancesReturnsOnCall(0, withStatus("rebooting"), nil)
+			fakeRDSSvc.DescribeDBInstancesReturns(withStatus("available"), nil)
+		})
+
+		It("reboots the instance", func() {
+			err := cfRDSApi.RebootInstance(context.Background(), "name", false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeRDSSvc.RebootDBInstanceArgsForCall(0)).To(Equal(&rds.RebootDBInstanceInput{
+				DBInstanceIdentifier: aws.String("name"),
+			}))
+		})
+
+		It("forces a failover when asked to", func() {
+			err := cfRDSApi.RebootInstance(context.Background(), "name", true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeRDSSvc.RebootDBInstanceArgsForCall(0).ForceFailover).To(Equal(aws.Bool(true)))
+		})
+
+		It("waits for the reboot to start before waiting for the instance to be available", func() {
+			fakeRDSSvc.DescribeDBInstancesReturnsOnCall(0, withStatus("available"), nil)
+			fakeRDSSvc.DescribeDBInstancesReturnsOnCall(1, withStatus("rebooting"), nil)
+			fakeRDSSvc.DescribeDBInstancesReturnsOnCall(2, withStatus("rebooting"), nil)
+
+			err := cfRDSApi.RebootInstance(context.Background(), "name", false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeRDSSvc.DescribeDBInstancesCallCount()).To(Equal(4))
+		})
+
+		It("stops waiting once the instance has failed", func() {
+			fakeRDSSvc.DescribeDBInstancesReturnsOnCall(0, withStatus("incompatible-parameters"), nil)
+
+			err := cfRDSApi.RebootInstance(context.Background(), "name", false)
+			Expect(err).To(MatchError("Error: RDS instance name is incompatible-parameters"))
+			Expect(fakeRDSSvc.DescribeDBInstancesCallCount()).To(Equal(1))
+		})
+	})
+})
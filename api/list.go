@@ -0,0 +1,34 @@
+package api
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+)
+
+// ListInstances returns every RDS instance in the region along with its tags,
+// following DescribeDBInstances markers until all pages have been read.
+func (f *CfRDSApi) ListInstances() ([]*DBInstance, error) {
+	instances := []*DBInstance{}
+	input := &rds.DescribeDBInstancesInput{}
+
+	for {
+		describeDBInstancesResp, err := f.Svc.DescribeDBInstances(input)
+		if err != nil {
+			return nil, credentialsError(err)
+		}
+
+		for _, dbInstance := range describeDBInstancesResp.DBInstances {
+			instance := newDBInstance(dbInstance)
+			instance.Tags, err = f.listTags(instance.ARN)
+			if err != nil {
+				return nil, err
+			}
+			instances = append(instances, instance)
+		}
+
+		if aws.StringValue(describeDBInstancesResp.Marker) == "" {
+			return instances, nil
+		}
+		input.Marker = describeDBInstancesResp.Marker
+	}
+}
@@ -0,0 +1,94 @@
+package api_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/seattle-beach/cf-cli-rds-plugin/api"
+	"github.com/seattle-beach/cf-cli-rds-plugin/api/fakes"
+)
+
+var _ = Describe("ListInstances", func() {
+	var fakeRDSSvc *fakes.FakeRDSService
+	var cfRDSApi *api.CfRDSApi
+
+	BeforeEach(func() {
+		fakeRDSSvc = &fakes.FakeRDSService{}
+		cfRDSApi = &api.CfRDSApi{
+			Svc: fakeRDSSvc,
+		}
+
+		fakeRDSSvc.DescribeDBInstancesReturnsOnCall(0, &rds.DescribeDBInstancesOutput{
+			DBInstances: []*rds.DBInstance{{
+				DBInstanceIdentifier: aws.String("first"),
+				DBInstanceClass:      aws.String("db.t2.micro"),
+				DBInstanceStatus:     aws.String("available"),
+				Engine:               aws.String("postgres"),
+				AllocatedStorage:     aws.Int64(20),
+				Endpoint: &rds.Endpoint{
+					Address: aws.String("first.us-east-1.rds.amazonaws.com"),
+					Port:    aws.Int64(5432),
+				},
+			}},
+			Marker: aws.String("page-2"),
+		}, nil)
+		fakeRDSSvc.DescribeDBInstancesReturnsOnCall(1, &rds.DescribeDBInstancesOutput{
+			DBInstances: []*rds.DBInstance{{
+				DBInstanceIdentifier: aws.String("second"),
+				DBInstanceStatus:     aws.String("creating"),
+				Engine:               aws.String("mysql"),
+			}},
+		}, nil)
+		fakeRDSSvc.ListTagsForResourceReturns(&rds.ListTagsForResourceOutput{}, nil)
+	})
+
+	It("follows markers until every page has been read", func() {
+		instances, err := cfRDSApi.ListInstances()
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeRDSSvc.DescribeDBInstancesCallCount()).To(Equal(2))
+		Expect(fakeRDSSvc.DescribeDBInstancesArgsForCall(1)).To(Equal(&rds.DescribeDBInstancesInput{
+			Marker: aws.String("page-2"),
+		}))
+		Expect(instances).To(HaveLen(2))
+		Expect(instances[1].InstanceName).To(Equal("second"))
+		Expect(instances[1].Status).To(Equal("creating"))
+	})
+
+	It("converts the AWS instances", func() {
+		instances, _ := cfRDSApi.ListInstances()
+		Expect(instances[0]).To(Equal(&api.DBInstance{
+			InstanceName:  "first",
+			InstanceClass: "db.t2.micro",
+			Status:        "available",
+			Engine:        "postgres",
+			Storage:       20,
+			Address:       "first.us-east-1.rds.amazonaws.com",
+			Port:          5432,
+			Tags:          map[string]string{},
+		}))
+	})
+
+	It("reads the tags of the instances", func() {
+		fakeRDSSvc.ListTagsForResourceReturns(&rds.ListTagsForResourceOutput{
+			TagList: []*rds.Tag{{Key: aws.String("cf-rds-service"), Value: aws.String("first")}},
+		}, nil)
+		instances, err := cfRDSApi.ListInstances()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(instances[0].Tags).To(Equal(map[string]string{"cf-rds-service": "first"}))
+	})
+
+	Context("when no AWS credentials are provided", func() {
+		BeforeEach(func() {
+			fakeRDSSvc.DescribeDBInstancesReturnsOnCall(0, nil, errors.New("NoCredentialProviders"))
+		})
+
+		It("should return helpful error", func() {
+			_, err := cfRDSApi.ListInstances()
+			Expect(err).To(MatchError("No valid AWS credentials found. Please see this document for help configuring the AWS SDK: https://github.com/aws/aws-sdk-go#configuring-credentials"))
+		})
+	})
+})
@@ -0,0 +1,183 @@
+package api
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/rds"
+)
+
+// maxParametersPerModify is the number of parameters RDS accepts in a single
+// ModifyDBParameterGroup call.
+const maxParametersPerModify = 20
+
+// ParameterGroupName returns the name of the DB parameter group dedicated to
+// the RDS instance of a service.
+func ParameterGroupName(serviceName string) string {
+	return "cf-rds-" + serviceName
+}
+
+// SetParameters creates the dedicated DB parameter group of the instance, or
+// modifies it if it exists, and attaches it to the instance. It returns the
+// names of the parameters that only take effect once the instance reboots;
+// when the group has just been attached, that is all of them.
+func (f *CfRDSApi) SetParameters(instanceName string, parameters map[string]string) ([]string, error) {
+	current, err := f.DescribeInstance(instanceName)
+	if err != nil {
+		return nil, err
+	}
+	if current.ClusterName != "" {
+		return nil, fmt.Errorf("Error: RDS instance %s belongs to Aurora DB cluster %s, whose parameters cannot be set with the plugin", instanceName, current.ClusterName)
+	}
+
+	groupName := ParameterGroupName(instanceName)
+	current.Tags, err = f.listTags(current.ARN)
+	if err != nil {
+		return nil, err
+	}
+	_, err = f.createParameterGroup(current, groupName)
+	if err != nil {
+		return nil, err
+	}
+
+	names, pendingReboot, err := f.modifyParameterGroup(groupName, current.Engine, parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	if current.ParameterGroup != nil && aws.StringValue(current.ParameterGroup.DBParameterGroupName) == groupName {
+		return pendingReboot, nil
+	}
+
+	// Instances only pick up a newly attached parameter group when they
+	// reboot.
+	_, err = f.Svc.ModifyDBInstance(&rds.ModifyDBInstanceInput{
+		DBInstanceIdentifier: aws.String(instanceName),
+		DBParameterGroupName: aws.String(groupName),
+		ApplyImmediately:     aws.Bool(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return names, nil
+}
+
+// modifyParameterGroup sets the parameters in the DB parameter group. It
+// returns the names of the parameters, sorted, and of those that only take
+// effect once the instance reboots.
+func (f *CfRDSApi) modifyParameterGroup(groupName string, engine string, parameters map[string]string) ([]string, []string, error) {
+	applyTypes := map[string]string{}
+	err := f.Svc.DescribeDBParametersPages(&rds.DescribeDBParametersInput{
+		DBParameterGroupName: aws.String(groupName),
+	}, func(page *rds.DescribeDBParametersOutput, lastPage bool) bool {
+		for _, parameter := range page.Parameters {
+			if aws.BoolValue(parameter.IsModifiable) {
+				applyTypes[aws.StringValue(parameter.ParameterName)] = aws.StringValue(parameter.ApplyType)
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, nil, credentialsError(err)
+	}
+
+	names := make([]string, 0, len(parameters))
+	for name := range parameters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var modifications []*rds.Parameter
+	var pendingReboot []string
+	for _, name := range names {
+		applyType, ok := applyTypes[name]
+		if !ok {
+			return nil, nil, fmt.Errorf("Error: %s is not a parameter of %s that can be modified", name, engine)
+		}
+		applyMethod := rds.ApplyMethodImmediate
+		if applyType == "static" {
+			applyMethod = rds.ApplyMethodPendingReboot
+			pendingReboot = append(pendingReboot, name)
+		}
+		modifications = append(modifications, &rds.Parameter{
+			ParameterName:  aws.String(name),
+			ParameterValue: aws.String(parameters[name]),
+			ApplyMethod:    aws.String(applyMethod),
+		})
+	}
+
+	for start := 0; start < len(modifications); start += maxParametersPerModify {
+		end := start + maxParametersPerModify
+		if end > len(modifications) {
+			end = len(modifications)
+		}
+		_, err = f.Svc.ModifyDBParameterGroup(&rds.ModifyDBParameterGroupInput{
+			DBParameterGroupName: aws.String(groupName),
+			Parameters:           modifications[start:end],
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return names, pendingReboot, nil
+}
+
+// createParameterGroup creates the DB parameter group for the engine version
+// of the instance, tagged like the instance, unless it already exists. It
+// reports whether it created the group.
+func (f *CfRDSApi) createParameterGroup(instance *DBInstance, groupName string) (bool, error) {
+	input := &rds.DescribeDBEngineVersionsInput{
+		Engine: aws.String(instance.Engine),
+	}
+	if instance.EngineVersion != "" {
+		input.EngineVersion = aws.String(instance.EngineVersion)
+	} else {
+		// New instances run the default version of the engine.
+		input.DefaultOnly = aws.Bool(true)
+	}
+	describeDBEngineVersionsResp, err := f.Svc.DescribeDBEngineVersions(input)
+	if err != nil {
+		return false, credentialsError(err)
+	}
+	if len(describeDBEngineVersionsResp.DBEngineVersions) == 0 {
+		return false, fmt.Errorf("Could not find engine version %s %s", instance.Engine, instance.EngineVersion)
+	}
+	family := describeDBEngineVersionsResp.DBEngineVersions[0].DBParameterGroupFamily
+
+	tags := map[string]string{ServiceTag: instance.InstanceName}
+	for key, value := range instance.Tags {
+		tags[key] = value
+	}
+
+	_, err = f.Svc.CreateDBParameterGroup(&rds.CreateDBParameterGroupInput{
+		DBParameterGroupName:   aws.String(groupName),
+		DBParameterGroupFamily: family,
+		Description:            aws.String("Parameters of cf service " + instance.InstanceName),
+		Tags:                   rdsTags(tags),
+	})
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == rds.ErrCodeDBParameterGroupAlreadyExistsFault {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// DeleteParameterGroup deletes the DB parameter group dedicated to the
+// service, if there is one. The instance using it must be gone.
+func (f *CfRDSApi) DeleteParameterGroup(serviceName string) error {
+	_, err := f.Svc.DeleteDBParameterGroup(&rds.DeleteDBParameterGroupInput{
+		DBParameterGroupName: aws.String(ParameterGroupName(serviceName)),
+	})
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == rds.ErrCodeDBParameterGroupNotFoundFault {
+		return nil
+	}
+	if err != nil {
+		return credentialsError(err)
+	}
+	return nil
+}
@@ -0,0 +1,277 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/rds"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/seattle-beach/cf-cli-rds-plugin/api"
+	"github.com/seattle-beach/cf-cli-rds-plugin/api/fakes"
+)
+
+var _ = Describe("parameter groups", func() {
+	var fakeRDSSvc *fakes.FakeRDSService
+	var cfRDSApi *api.CfRDSApi
+	var dbInstance *rds.DBInstance
+
+	BeforeEach(func() {
+		fakeRDSSvc = &fakes.FakeRDSService{}
+		cfRDSApi = &api.CfRDSApi{
+			Svc: fakeRDSSvc,
+		}
+
+		dbInstance = &rds.DBInstance{
+			DBInstanceIdentifier: aws.String("name"),
+			Engine:               aws.String("postgres"),
+			EngineVersion:        aws.String("9.6.5"),
+			DBParameterGroups: []*rds.DBParameterGroupStatus{{
+				DBParameterGroupName: aws.String("default.postgres9.6"),
+				ParameterApplyStatus: aws.String("in-sync"),
+			}},
+		}
+		fakeRDSSvc.DescribeDBInstancesStub = func(input *rds.DescribeDBInstancesInput) (*rds.DescribeDBInstancesOutput, error) {
+			return &rds.DescribeDBInstancesOutput{DBInstances: []*rds.DBInstance{dbInstance}}, nil
+		}
+		fakeRDSSvc.ListTagsForResourceReturns(&rds.ListTagsForResourceOutput{}, nil)
+		fakeRDSSvc.DescribeDBEngineVersionsReturns(&rds.DescribeDBEngineVersionsOutput{
+			DBEngineVersions: []*rds.DBEngineVersion{{
+				DBParameterGroupFamily: aws.String("postgres9.6"),
+			}},
+		}, nil)
+		fakeRDSSvc.DescribeDBParametersPagesStub = func(input *rds.DescribeDBParametersInput, fn func(*rds.DescribeDBParametersOutput, bool) bool) error {
+			fn(&rds.DescribeDBParametersOutput{Parameters: []*rds.Parameter{
+				{ParameterName: aws.String("max_connections"), ApplyType: aws.String("static"), IsModifiable: aws.Bool(true)},
+				{ParameterName: aws.String("rds.extensions"), ApplyType: aws.String("static"), IsModifiable: aws.Bool(false)},
+			}}, false)
+			fn(&rds.DescribeDBParametersOutput{Parameters: []*rds.Parameter{
+				{ParameterName: aws.String("work_mem"), ApplyType: aws.String("dynamic"), IsModifiable: aws.Bool(true)},
+			}}, true)
+			return nil
+		}
+	})
+
+	Describe("SetParameters", func() {
+		It("creates a parameter group for the engine version of the instance", func() {
+			_, err := cfRDSApi.SetParameters("name", map[string]string{"work_mem": "8192"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeRDSSvc.DescribeDBEngineVersionsArgsForCall(0)).To(Equal(&rds.DescribeDBEngineVersionsInput{
+				Engine:        aws.String("postgres"),
+				EngineVersion: aws.String("9.6.5"),
+			}))
+			Expect(fakeRDSSvc.CreateDBParameterGroupArgsForCall(0)).To(Equal(&rds.CreateDBParameterGroupInput{
+				DBParameterGroupName:   aws.String("cf-rds-name"),
+				DBParameterGroupFamily: aws.String("postgres9.6"),
+				Description:            aws.String("Parameters of cf service name"),
+				Tags: []*rds.Tag{{
+					Key:   aws.String("cf-rds-service"),
+					Value: aws.String("name"),
+				}},
+			}))
+		})
+
+		It("tags the parameter group like the instance", func() {
+			fakeRDSSvc.ListTagsForResourceReturns(&rds.ListTagsForResourceOutput{
+				TagList: []*rds.Tag{
+					{Key: aws.String("cf-rds-service"), Value: aws.String("service")},
+					{Key: aws.String("cf-rds-space-guid"), Value: aws.String("space-guid")},
+				},
+			}, nil)
+			_, err := cfRDSApi.SetParameters("name", map[string]string{"work_mem": "8192"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeRDSSvc.CreateDBParameterGroupArgsForCall(0).Tags).To(Equal([]*rds.Tag{
+				{Key: aws.String("cf-rds-service"), Value: aws.String("service")},
+				{Key: aws.String("cf-rds-space-guid"), Value: aws.String("space-guid")},
+			}))
+		})
+
+		It("sets static parameters pending a reboot and dynamic ones immediately", func() {
+			_, err := cfRDSApi.SetParameters("name", map[string]string{"work_mem": "8192", "max_connections": "200"})
+			Expect(err).NotTo(HaveOccurred())
+
+			input, _ := fakeRDSSvc.DescribeDBParametersPagesArgsForCall(0)
+			Expect(input).To(Equal(&rds.DescribeDBParametersInput{
+				DBParameterGroupName: aws.String("cf-rds-name"),
+			}))
+			Expect(fakeRDSSvc.ModifyDBParameterGroupArgsForCall(0)).To(Equal(&rds.ModifyDBParameterGroupInput{
+				DBParameterGroupName: aws.String("cf-rds-name"),
+				Parameters: []*rds.Parameter{
+					{ParameterName: aws.String("max_connections"), ParameterValue: aws.String("200"), ApplyMethod: aws.String("pending-reboot")},
+					{ParameterName: aws.String("work_mem"), ParameterValue: aws.String("8192"), ApplyMethod: aws.String("immediate")},
+				},
+			}))
+		})
+
+		It("attaches a new parameter group, which takes effect once the instance reboots", func() {
+			pendingReboot, err := cfRDSApi.SetParameters("name", map[string]string{"work_mem": "8192", "max_connections": "200"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeRDSSvc.ModifyDBInstanceArgsForCall(0)).To(Equal(&rds.ModifyDBInstanceInput{
+				DBInstanceIdentifier: aws.String("name"),
+				DBParameterGroupName: aws.String("cf-rds-name"),
+				ApplyImmediately:     aws.Bool(true),
+			}))
+			Expect(pendingReboot).To(Equal([]string{"max_connections", "work_mem"}))
+		})
+
+		Context("when the instance uses its parameter group already", func() {
+			BeforeEach(func() {
+				dbInstance.DBParameterGroups[0].DBParameterGroupName = aws.String("cf-rds-name")
+				fakeRDSSvc.CreateDBParameterGroupReturns(nil, awserr.New(rds.ErrCodeDBParameterGroupAlreadyExistsFault, "Parameter group cf-rds-name already exists", nil))
+			})
+
+			It("modifies the group and reports only the static parameters", func() {
+				pendingReboot, err := cfRDSApi.SetParameters("name", map[string]string{"work_mem": "8192", "max_connections": "200"})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeRDSSvc.ModifyDBParameterGroupCallCount()).To(Equal(1))
+				Expect(fakeRDSSvc.ModifyDBInstanceCallCount()).To(Equal(0))
+				Expect(pendingReboot).To(Equal([]string{"max_connections"}))
+			})
+		})
+
+		It("modifies at most 20 parameters at a time", func() {
+			parameters := map[string]string{}
+			var page []*rds.Parameter
+			for n := 0; n < 25; n++ {
+				name := fmt.Sprintf("parameter%02d", n)
+				parameters[name] = "1"
+				page = append(page, &rds.Parameter{ParameterName: aws.String(name), ApplyType: aws.String("dynamic"), IsModifiable: aws.Bool(true)})
+			}
+			fakeRDSSvc.DescribeDBParametersPagesStub = func(input *rds.DescribeDBParametersInput, fn func(*rds.DescribeDBParametersOutput, bool) bool) error {
+				fn(&rds.DescribeDBParametersOutput{Parameters: page}, true)
+				return nil
+			}
+
+			_, err := cfRDSApi.SetParameters("name", parameters)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeRDSSvc.ModifyDBParameterGroupCallCount()).To(Equal(2))
+			Expect(fakeRDSSvc.ModifyDBParameterGroupArgsForCall(0).Parameters).To(HaveLen(20))
+			Expect(fakeRDSSvc.ModifyDBParameterGroupArgsForCall(1).Parameters).To(HaveLen(5))
+		})
+
+		Context("error cases", func() {
+			It("rejects unknown parameters before modifying anything", func() {
+				_, err := cfRDSApi.SetParameters("name", map[string]string{"max_conns": "200"})
+				Expect(err).To(MatchError("Error: max_conns is not a parameter of postgres that can be modified"))
+				Expect(fakeRDSSvc.ModifyDBParameterGroupCallCount()).To(Equal(0))
+				Expect(fakeRDSSvc.ModifyDBInstanceCallCount()).To(Equal(0))
+			})
+
+			It("rejects parameters that cannot be modified", func() {
+				_, err := cfRDSApi.SetParameters("name", map[string]string{"rds.extensions": "postgis"})
+				Expect(err).To(MatchError("Error: rds.extensions is not a parameter of postgres that can be modified"))
+			})
+
+			It("rejects members of Aurora DB clusters", func() {
+				dbInstance.DBClusterIdentifier = aws.String("name")
+				_, err := cfRDSApi.SetParameters("name", map[string]string{"work_mem": "8192"})
+				Expect(err).To(MatchError("Error: RDS instance name belongs to Aurora DB cluster name, whose parameters cannot be set with the plugin"))
+				Expect(fakeRDSSvc.CreateDBParameterGroupCallCount()).To(Equal(0))
+			})
+
+			It("returns the error if the parameter group cannot be created", func() {
+				fakeRDSSvc.CreateDBParameterGroupReturns(nil, errors.New("DBParameterGroupQuotaExceeded"))
+				_, err := cfRDSApi.SetParameters("name", map[string]string{"work_mem": "8192"})
+				Expect(err).To(MatchError("DBParameterGroupQuotaExceeded"))
+				Expect(fakeRDSSvc.ModifyDBParameterGroupCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("CreateInstance with parameters", func() {
+		var instance *api.DBInstance
+
+		BeforeEach(func() {
+			instance = &api.DBInstance{
+				InstanceName: "name",
+				SubnetGroup: &rds.DBSubnetGroup{
+					DBSubnetGroupName: aws.String("default-vpc-vpcid"),
+				},
+				Engine:     "postgres",
+				Username:   "root",
+				Parameters: map[string]string{"max_connections": "200", "work_mem": "8192"},
+			}
+			fakeRDSSvc.CreateDBInstanceReturns(&rds.CreateDBInstanceOutput{
+				DBInstance: &rds.DBInstance{
+					DbiResourceId: aws.String("resourceid"),
+					DBInstanceArn: aws.String("arn:aws:rds:us-east-1:10101010:db:name"),
+					VpcSecurityGroups: []*rds.VpcSecurityGroupMembership{{
+						VpcSecurityGroupId: aws.String("vpcgroup"),
+					}},
+				},
+			}, nil)
+			dbInstance.DBInstanceStatus = aws.String("available")
+			api.GeneratePassword = func(engine string) (string, error) {
+				return "password", nil
+			}
+			api.GenerateDBName = func(engine string) (string, error) {
+				return "database", nil
+			}
+		})
+
+		It("creates the instance in a parameter group of the default engine version holding the parameters", func() {
+			err := cfRDSApi.CreateInstance(context.Background(), instance)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeRDSSvc.DescribeDBEngineVersionsArgsForCall(0)).To(Equal(&rds.DescribeDBEngineVersionsInput{
+				Engine:      aws.String("postgres"),
+				DefaultOnly: aws.Bool(true),
+			}))
+			Expect(fakeRDSSvc.CreateDBParameterGroupArgsForCall(0).DBParameterGroupName).To(Equal(aws.String("cf-rds-name")))
+			Expect(fakeRDSSvc.ModifyDBParameterGroupArgsForCall(0).Parameters).To(HaveLen(2))
+			Expect(fakeRDSSvc.CreateDBInstanceArgsForCall(0).DBParameterGroupName).To(Equal(aws.String("cf-rds-name")))
+		})
+
+		It("records the parameter group it created", func() {
+			err := cfRDSApi.CreateInstance(context.Background(), instance)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(instance.ParameterGroup.DBParameterGroupName).To(Equal(aws.String("cf-rds-name")))
+		})
+
+		It("leaves a parameter group that already exists alone", func() {
+			fakeRDSSvc.CreateDBParameterGroupReturns(nil, awserr.New(rds.ErrCodeDBParameterGroupAlreadyExistsFault, "Parameter group cf-rds-name already exists", nil))
+			err := cfRDSApi.CreateInstance(context.Background(), instance)
+			Expect(err).To(MatchError("Error: DB parameter group cf-rds-name already exists"))
+			Expect(fakeRDSSvc.ModifyDBParameterGroupCallCount()).To(Equal(0))
+			Expect(fakeRDSSvc.CreateDBInstanceCallCount()).To(Equal(0))
+			Expect(instance.ParameterGroup).To(BeNil())
+		})
+
+		It("does not create the instance if the parameters cannot be set", func() {
+			instance.Parameters = map[string]string{"shared_preload_libraries": "pg_stat_statements"}
+			err := cfRDSApi.CreateInstance(context.Background(), instance)
+			Expect(err).To(MatchError("Error: shared_preload_libraries is not a parameter of postgres that can be modified"))
+			Expect(fakeRDSSvc.CreateDBInstanceCallCount()).To(Equal(0))
+			Expect(instance.ARN).To(BeEmpty())
+		})
+	})
+
+	Describe("DeleteParameterGroup", func() {
+		It("deletes the parameter group of the service", func() {
+			err := cfRDSApi.DeleteParameterGroup("name")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeRDSSvc.DeleteDBParameterGroupArgsForCall(0)).To(Equal(&rds.DeleteDBParameterGroupInput{
+				DBParameterGroupName: aws.String("cf-rds-name"),
+			}))
+		})
+
+		It("succeeds if the service has no parameter group", func() {
+			fakeRDSSvc.DeleteDBParameterGroupReturns(nil, awserr.New(rds.ErrCodeDBParameterGroupNotFoundFault, "DBParameterGroup not found", nil))
+			err := cfRDSApi.DeleteParameterGroup("name")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns other errors", func() {
+			fakeRDSSvc.DeleteDBParameterGroupReturns(nil, errors.New("InvalidDBParameterGroupState"))
+			err := cfRDSApi.DeleteParameterGroup("name")
+			Expect(err).To(MatchError("InvalidDBParameterGroupState"))
+		})
+	})
+})
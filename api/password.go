@@ -0,0 +1,95 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+)
+
+// ResetPassword changes the master password of the RDS instance immediately
+// and waits until RDS reports the change as applied. On success the
+// instance's Password and DBURI hold the new credentials. The password of
+// an Aurora instance is that of its DB cluster.
+func (f *CfRDSApi) ResetPassword(ctx context.Context, instance *DBInstance, password string) error {
+	if instance.ClusterName != "" {
+		return f.resetClusterPassword(ctx, instance, password)
+	}
+
+	_, err := f.Svc.ModifyDBInstance(&rds.ModifyDBInstanceInput{
+		DBInstanceIdentifier: aws.String(instance.InstanceName),
+		MasterUserPassword:   aws.String(password),
+		ApplyImmediately:     aws.Bool(true),
+	})
+	if err != nil {
+		return credentialsError(err)
+	}
+
+	return f.waitForPasswordReset(ctx, instance, password)
+}
+
+// waitForPasswordReset polls until the instance is available and no longer
+// lists the master password as a pending modification.
+func (f *CfRDSApi) waitForPasswordReset(ctx context.Context, instance *DBInstance, password string) error {
+	for {
+		err := f.Svc.WaitUntilDBInstanceAvailableWithContext(ctx, &rds.DescribeDBInstancesInput{
+			DBInstanceIdentifier: aws.String(instance.InstanceName),
+		}, f.waiterOptions(ctx)...)
+		if err != nil {
+			return contextError(ctx, err)
+		}
+
+		current, err := f.DescribeInstance(instance.InstanceName)
+		if err != nil {
+			return err
+		}
+		reportInstanceProgress(ctx, current)
+
+		pending := current.PendingModifications
+		if current.Status == "available" && (pending == nil || pending.MasterUserPassword == nil) {
+			instance.Password = password
+			instance.setURI(current.Address, current.Port)
+			return nil
+		}
+
+		err = f.pause(ctx)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// resetClusterPassword changes the master password of the DB cluster of an
+// Aurora instance and waits until the cluster and its members are available.
+// The instance's URIs then point at the endpoints of the cluster.
+func (f *CfRDSApi) resetClusterPassword(ctx context.Context, instance *DBInstance, password string) error {
+	cluster, err := f.findCluster(instance.ClusterName)
+	if err != nil {
+		return err
+	}
+	if cluster == nil {
+		return fmt.Errorf("Could not find DB cluster %s", instance.ClusterName)
+	}
+
+	_, err = f.Svc.ModifyDBCluster(&rds.ModifyDBClusterInput{
+		DBClusterIdentifier: aws.String(instance.ClusterName),
+		MasterUserPassword:  aws.String(password),
+		ApplyImmediately:    aws.Bool(true),
+	})
+	if err != nil {
+		return credentialsError(err)
+	}
+
+	err = f.waitForCluster(ctx, instance, false)
+	if err != nil {
+		return err
+	}
+	instance.ARN = aws.StringValue(cluster.DBClusterArn)
+	instance.ResourceID = aws.StringValue(cluster.DbClusterResourceId)
+	instance.Username = aws.StringValue(cluster.MasterUsername)
+	instance.DBName = aws.StringValue(cluster.DatabaseName)
+	instance.Password = password
+	instance.setURI(instance.Address, instance.Port)
+	return nil
+}
@@ -0,0 +1,99 @@
+package api
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/rds"
+)
+
+// Progress is reported every time a long-running operation polls RDS.
+type Progress struct {
+	// Resource is what the operation waits for, "DB instance",
+	// "DB cluster" or "DB snapshot".
+	Resource string
+	Name     string
+	Status   string
+	// PreviousStatus is the status reported by the previous poll. It is
+	// empty on the first one.
+	PreviousStatus string
+	// Percent is the progress RDS reports, or -1 if it reports none.
+	Percent int64
+	// Elapsed is the time since the operation started.
+	Elapsed time.Duration
+}
+
+// StatusChanged reports whether the status differs from the one reported by
+// the previous poll.
+func (p Progress) StatusChanged() bool {
+	return p.Status != p.PreviousStatus
+}
+
+type progressKey struct{}
+
+type progressReporter struct {
+	start  time.Time
+	report func(Progress)
+
+	mu       sync.Mutex
+	statuses map[string]string
+}
+
+// WithProgress returns a context that makes the operations run in it report
+// their progress to report. report is called on the goroutine running the
+// operation.
+func WithProgress(ctx context.Context, report func(Progress)) context.Context {
+	return context.WithValue(ctx, progressKey{}, &progressReporter{
+		start:    time.Now(),
+		report:   report,
+		statuses: map[string]string{},
+	})
+}
+
+// ReportProgress reports the status of a resource to the operation run in
+// ctx. It does nothing unless ctx was made by WithProgress.
+func ReportProgress(ctx context.Context, resource string, name string, status string, percent int64) {
+	reporter, ok := ctx.Value(progressKey{}).(*progressReporter)
+	if !ok {
+		return
+	}
+
+	reporter.mu.Lock()
+	key := resource + "/" + name
+	previousStatus := reporter.statuses[key]
+	reporter.statuses[key] = status
+	reporter.mu.Unlock()
+
+	reporter.report(Progress{
+		Resource:       resource,
+		Name:           name,
+		Status:         status,
+		PreviousStatus: previousStatus,
+		Percent:        percent,
+		Elapsed:        time.Since(reporter.start),
+	})
+}
+
+func reportInstanceProgress(ctx context.Context, instance *DBInstance) {
+	ReportProgress(ctx, "DB instance", instance.InstanceName, instance.Status, -1)
+}
+
+// instanceProgress hooks into the requests of the SDK's waiters and reports
+// the status of every instance in the DescribeDBInstances responses.
+func instanceProgress(ctx context.Context) request.Option {
+	return func(r *request.Request) {
+		r.Handlers.Complete.PushBack(func(r *request.Request) {
+			describeDBInstancesResp, ok := r.Data.(*rds.DescribeDBInstancesOutput)
+			if !ok || r.Error != nil {
+				return
+			}
+
+			for _, dbInstance := range describeDBInstancesResp.DBInstances {
+				ReportProgress(ctx, "DB instance", aws.StringValue(dbInstance.DBInstanceIdentifier), aws.StringValue(dbInstance.DBInstanceStatus), -1)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package api_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/rds"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/seattle-beach/cf-cli-rds-plugin/api"
+	"github.com/seattle-beach/cf-cli-rds-plugin/api/fakes"
+)
+
+var _ = Describe("Progress", func() {
+	var fakeRDSSvc *fakes.FakeRDSService
+	var cfRDSApi *api.CfRDSApi
+	var events []api.Progress
+	var ctx context.Context
+
+	withStatus := func(status string) *rds.DescribeDBInstancesOutput {
+		return &rds.DescribeDBInstancesOutput{
+			DBInstances: []*rds.DBInstance{{
+				DBInstanceIdentifier: aws.String("name"),
+				DBInstanceStatus:     aws.String(status),
+			}},
+		}
+	}
+
+	BeforeEach(func() {
+		fakeRDSSvc = &fakes.FakeRDSService{}
+		cfRDSApi = &api.CfRDSApi{
+			Svc:          fakeRDSSvc,
+			PollInterval: time.Millisecond,
+		}
+		events = nil
+		ctx = api.WithProgress(context.Background(), func(progress api.Progress) {
+			events = append(events, progress)
+		})
+	})
+
+	It("reports the status of the instance on every poll", func() {
+		fakeRDSSvc.DescribeDBInstancesReturnsOnCall(0, withStatus("rebooting"), nil)
+		fakeRDSSvc.DescribeDBInstancesReturnsOnCall(1, withStatus("rebooting"), nil)
+		fakeRDSSvc.DescribeDBInstancesReturnsOnCall(2, withStatus("available"), nil)
+
+		err := cfRDSApi.RebootInstance(ctx, "name", false)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(events).To(HaveLen(3))
+		Expect(events[0].Resource).To(Equal("DB instance"))
+		Expect(events[0].Name).To(Equal("name"))
+		Expect(events[0].Status).To(Equal("rebooting"))
+		Expect(events[0].PreviousStatus).To(BeEmpty())
+		Expect(events[0].Percent).To(Equal(int64(-1)))
+		Expect(events[1].StatusChanged()).To(BeFalse())
+		Expect(events[2].PreviousStatus).To(Equal("rebooting"))
+		Expect(events[2].Status).To(Equal("available"))
+		Expect(events[2].Elapsed).To(BeNumerically(">=", events[0].Elapsed))
+	})
+
+	It("reports the percent progress of DB snapshots", func() {
+		fakeRDSSvc.DescribeDBInstancesReturns(&rds.DescribeDBInstancesOutput{
+			DBInstances: []*rds.DBInstance{{DBInstanceIdentifier: aws.String("name")}},
+		}, nil)
+		fakeRDSSvc.DescribeDBSnapshotsReturnsOnCall(0, &rds.DescribeDBSnapshotsOutput{
+			DBSnapshots: []*rds.DBSnapshot{{
+				Status:          aws.String("creating"),
+				PercentProgress: aws.Int64(40),
+			}},
+		}, nil)
+		fakeRDSSvc.DescribeDBSnapshotsReturnsOnCall(1, &rds.DescribeDBSnapshotsOutput{
+			DBSnapshots: []*rds.DBSnapshot{{
+				Status: aws.String("available"),
+			}},
+		}, nil)
+
+		err := cfRDSApi.CreateSnapshot(ctx, "name", "before-upgrade")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(events).To(HaveLen(2))
+		Expect(events[0].Resource).To(Equal("DB snapshot"))
+		Expect(events[0].Name).To(Equal("before-upgrade"))
+		Expect(events[0].Percent).To(Equal(int64(40)))
+		Expect(events[1].Status).To(Equal("available"))
+		Expect(events[1].Percent).To(Equal(int64(-1)))
+	})
+
+	It("reports the statuses the SDK's waiters see", func() {
+		fakeRDSSvc.DescribeDBClustersReturns(&rds.DescribeDBClustersOutput{}, nil)
+		err := cfRDSApi.WaitForDeletion(ctx, &api.DBInstance{InstanceName: "name"}, "")
+		Expect(err).NotTo(HaveOccurred())
+
+		_, _, opts := fakeRDSSvc.WaitUntilDBInstanceDeletedWithContextArgsForCall(0)
+		waiter := request.Waiter{}
+		waiter.ApplyOptions(opts...)
+		req := &request.Request{Data: withStatus("deleting")}
+		for _, opt := range waiter.RequestOptions {
+			opt(req)
+		}
+		req.Handlers.Complete.Run(req)
+
+		Expect(events).To(HaveLen(1))
+		Expect(events[0].Name).To(Equal("name"))
+		Expect(events[0].Status).To(Equal("deleting"))
+	})
+
+	It("reports nothing unless asked to", func() {
+		fakeRDSSvc.DescribeDBInstancesReturns(withStatus("available"), nil)
+
+		err := cfRDSApi.StartInstance(context.Background(), "name")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(events).To(BeEmpty())
+	})
+})
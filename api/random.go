@@ -0,0 +1,104 @@
+package api
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"strings"
+)
+
+const (
+	LowerCase = "abcdefghijklmnopqrstuvwxyz"
+	UpperCase = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	Digits    = "0123456789"
+
+	// PasswordSymbols are the unreserved URI characters, so they never need
+	// escaping in a connection URI and are accepted by every RDS engine.
+	PasswordSymbols = "-_.~"
+)
+
+// StringRules describe a random string: its length, the character classes it
+// is drawn from (each class appears at least once) and, optionally, the
+// characters allowed in the first position.
+type StringRules struct {
+	Length     int
+	Classes    []string
+	FirstClass string
+}
+
+// maxAttempts bounds the rejection sampling in GenerateString. With the rules
+// used by this package nearly every attempt succeeds.
+const maxAttempts = 100
+
+// GenerateString returns a random string following rules, using crypto/rand.
+func GenerateString(rules StringRules) (string, error) {
+	alphabet := strings.Join(rules.Classes, "")
+	if rules.Length <= 0 || alphabet == "" || rules.Length < len(rules.Classes) {
+		return "", errors.New("Error: cannot generate a random string from these rules")
+	}
+
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		b := make([]byte, rules.Length)
+		for i := range b {
+			chars := alphabet
+			if i == 0 && rules.FirstClass != "" {
+				chars = rules.FirstClass
+			}
+
+			n, err := rand.Int(rand.Reader, big.NewInt(int64(len(chars))))
+			if err != nil {
+				return "", err
+			}
+			b[i] = chars[n.Int64()]
+		}
+
+		if containsEveryClass(string(b), rules.Classes) {
+			return string(b), nil
+		}
+	}
+
+	return "", errors.New("Error: could not generate a random string matching the rules")
+}
+
+func containsEveryClass(s string, classes []string) bool {
+	for _, class := range classes {
+		if !strings.ContainsAny(s, class) {
+			return false
+		}
+	}
+	return true
+}
+
+// defaultEngine supplies the rules for engines missing from Engines.
+var defaultEngine = postgresEngine
+
+// PasswordRules returns the rules for master passwords of the given engine.
+func PasswordRules(engine string) StringRules {
+	e, err := LookupEngine(engine)
+	if err != nil {
+		e = defaultEngine
+	}
+	return e.PasswordRules
+}
+
+// DBNameRules returns the rules for database names of the given engine, or
+// nil if the engine does not take a database name.
+func DBNameRules(engine string) *StringRules {
+	e, err := LookupEngine(engine)
+	if err != nil {
+		e = defaultEngine
+	}
+	return e.DBNameRules
+}
+
+var GeneratePassword = func(engine string) (string, error) {
+	return GenerateString(PasswordRules(engine))
+}
+
+var GenerateDBName = func(engine string) (string, error) {
+	rules := DBNameRules(engine)
+	if rules == nil {
+		return "", nil
+	}
+	return GenerateString(*rules)
+}
@@ -0,0 +1,85 @@
+package api_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/seattle-beach/cf-cli-rds-plugin/api"
+)
+
+var _ = Describe("Random strings", func() {
+	Describe("GenerateString", func() {
+		It("generates a string of the given length with every character class", func() {
+			s, err := api.GenerateString(api.StringRules{
+				Length:  12,
+				Classes: []string{api.LowerCase, api.Digits},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(s).To(HaveLen(12))
+			Expect(s).To(MatchRegexp("^[a-z0-9]+$"))
+			Expect(s).To(MatchRegexp("[a-z]"))
+			Expect(s).To(MatchRegexp("[0-9]"))
+		})
+
+		It("draws the first character from FirstClass", func() {
+			for i := 0; i < 20; i++ {
+				s, err := api.GenerateString(api.StringRules{
+					Length:     5,
+					Classes:    []string{api.Digits},
+					FirstClass: "x",
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(s).To(HavePrefix("x"))
+			}
+		})
+
+		It("does not repeat itself", func() {
+			rules := api.PasswordRules("postgres")
+			first, _ := api.GenerateString(rules)
+			second, _ := api.GenerateString(rules)
+			Expect(first).NotTo(Equal(second))
+		})
+
+		It("rejects rules that cannot be satisfied", func() {
+			_, err := api.GenerateString(api.StringRules{
+				Length:  1,
+				Classes: []string{api.LowerCase, api.Digits},
+			})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("PasswordRules", func() {
+		It("keeps MySQL passwords within 41 characters", func() {
+			password, err := api.GenerateString(api.PasswordRules("mysql"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(len(password)).To(BeNumerically("<=", 41))
+		})
+
+		It("keeps Oracle passwords within 30 characters, starting with a letter and using only _ as a symbol", func() {
+			password, err := api.GenerateString(api.PasswordRules("oracle-se2"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(len(password)).To(BeNumerically("<=", 30))
+			Expect(password).To(MatchRegexp("^[a-zA-Z][a-zA-Z0-9_]+$"))
+		})
+
+		It("only uses symbols that are safe in URIs", func() {
+			password, err := api.GenerateString(api.PasswordRules("postgres"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(strings.Trim(password, api.LowerCase+api.UpperCase+api.Digits+api.PasswordSymbols)).To(BeEmpty())
+		})
+	})
+
+	Describe("DBNameRules", func() {
+		It("keeps Oracle database names within 8 characters", func() {
+			name, err := api.GenerateString(*api.DBNameRules("oracle-ee"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(name).To(MatchRegexp("^[a-z]{8}$"))
+		})
+
+		It("has no rules for SQL Server, which takes no database name", func() {
+			Expect(api.DBNameRules("sqlserver-ex")).To(BeNil())
+		})
+	})
+})
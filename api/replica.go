@@ -0,0 +1,107 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+)
+
+// CreateReplica creates a read replica of the source instance, given by name
+// or ARN, and waits until it is available. The replica shares the master
+// credentials of its source, so the caller fills in replica.Username,
+// replica.Password and replica.DBName to build its URIs. A replica in
+// another region than its source is created in replica.SubnetGroup.
+func (f *CfRDSApi) CreateReplica(ctx context.Context, replica *DBInstance, source string) error {
+	input := &rds.CreateDBInstanceReadReplicaInput{
+		DBInstanceIdentifier:       aws.String(replica.InstanceName),
+		SourceDBInstanceIdentifier: aws.String(source),
+		CopyTagsToSnapshot:         aws.Bool(true),
+		Tags:                       rdsTags(replica.Tags),
+	}
+	if replica.InstanceClass != "" {
+		input.DBInstanceClass = aws.String(replica.InstanceClass)
+	}
+	if sourceRegion := ARNRegion(source); sourceRegion != "" && sourceRegion != f.Region {
+		if replica.SubnetGroup == nil {
+			return errors.New("Error: a read replica in another region than its source needs a DB subnet group")
+		}
+		input.SourceRegion = aws.String(sourceRegion)
+		input.DBSubnetGroupName = replica.SubnetGroup.DBSubnetGroupName
+	}
+
+	createReplicaResp, err := f.Svc.CreateDBInstanceReadReplica(input)
+	if err != nil {
+		return credentialsError(err)
+	}
+
+	dbInstance := createReplicaResp.DBInstance
+	if len(dbInstance.VpcSecurityGroups) == 0 {
+		return errors.New("Error: do not have any VPC security groups to associate with RDS instance")
+	}
+
+	replica.ARN = aws.StringValue(dbInstance.DBInstanceArn)
+	replica.ResourceID = aws.StringValue(dbInstance.DbiResourceId)
+	replica.SecGroups = dbInstance.VpcSecurityGroups
+	replica.SubnetGroup = dbInstance.DBSubnetGroup
+	replica.Engine = aws.StringValue(dbInstance.Engine)
+	replica.ReplicaSource = source
+
+	return f.waitForInstance(ctx, replica, false)
+}
+
+// PromoteReplica turns the read replica into a standalone instance, waits
+// until the promotion is done and gives the instance a master password of its
+// own, which it waits for RDS to apply as ResetPassword does.
+func (f *CfRDSApi) PromoteReplica(ctx context.Context, instance *DBInstance) error {
+	_, err := f.Svc.PromoteReadReplica(&rds.PromoteReadReplicaInput{
+		DBInstanceIdentifier: aws.String(instance.InstanceName),
+	})
+	if err != nil {
+		return credentialsError(err)
+	}
+
+	// The replica stays available while RDS starts promoting it, so wait
+	// for it to lose its source rather than for its status.
+	for {
+		current, err := f.DescribeInstance(instance.InstanceName)
+		if err != nil {
+			return err
+		}
+		reportInstanceProgress(ctx, current)
+
+		if current.Status == "available" && current.ReplicaSource == "" {
+			instance.ARN = current.ARN
+			instance.ResourceID = current.ResourceID
+			instance.Username = current.Username
+			instance.DBName = current.DBName
+			instance.SecGroups = current.SecGroups
+			instance.SubnetGroup = current.SubnetGroup
+			instance.Engine = current.Engine
+			break
+		}
+
+		err = f.pause(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	password, err := GeneratePassword(instance.Engine)
+	if err != nil {
+		return err
+	}
+	return f.ResetPassword(ctx, instance, password)
+}
+
+// ARNRegion returns the region of an ARN, or an empty string if arn is not
+// an ARN.
+func ARNRegion(arn string) string {
+	parts := strings.Split(arn, ":")
+	if len(parts) < 6 || parts[0] != "arn" {
+		return ""
+	}
+	return parts[3]
+}
@@ -0,0 +1,114 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+)
+
+// RestoreFromSnapshot creates a new RDS instance named after instance from the
+// DB snapshot, in instance.SubnetGroup if given. Once the instance is
+// available its master password is reset, since the snapshot carries the
+// password of the original instance, and instance.SecGroups, if any, replace
+// its security groups.
+func (f *CfRDSApi) RestoreFromSnapshot(ctx context.Context, instance *DBInstance, snapshotName string) error {
+	input := &rds.RestoreDBInstanceFromDBSnapshotInput{
+		DBInstanceIdentifier: aws.String(instance.InstanceName),
+		DBSnapshotIdentifier: aws.String(snapshotName),
+		CopyTagsToSnapshot:   aws.Bool(true),
+		PubliclyAccessible:   aws.Bool(instance.PubliclyAccessible),
+		Tags:                 rdsTags(instance.Tags),
+	}
+	if instance.SubnetGroup != nil {
+		input.DBSubnetGroupName = instance.SubnetGroup.DBSubnetGroupName
+	}
+
+	restoreResp, err := f.Svc.RestoreDBInstanceFromDBSnapshot(input)
+	if err != nil {
+		return credentialsError(err)
+	}
+
+	return f.waitForRestore(ctx, instance, restoreResp.DBInstance)
+}
+
+// RestoreToPointInTime creates a new RDS instance named after instance from
+// the state the source instance was in at restoreTime, or at the latest
+// restorable time if restoreTime is zero. As with RestoreFromSnapshot the
+// instance goes in instance.SubnetGroup if given, and its master password and
+// security groups are set once the instance is available.
+func (f *CfRDSApi) RestoreToPointInTime(ctx context.Context, instance *DBInstance, sourceInstanceName string, restoreTime time.Time) error {
+	input := &rds.RestoreDBInstanceToPointInTimeInput{
+		SourceDBInstanceIdentifier: aws.String(sourceInstanceName),
+		TargetDBInstanceIdentifier: aws.String(instance.InstanceName),
+		CopyTagsToSnapshot:         aws.Bool(true),
+		PubliclyAccessible:         aws.Bool(instance.PubliclyAccessible),
+		Tags:                       rdsTags(instance.Tags),
+	}
+	if restoreTime.IsZero() {
+		input.UseLatestRestorableTime = aws.Bool(true)
+	} else {
+		input.RestoreTime = aws.Time(restoreTime)
+	}
+	if instance.SubnetGroup != nil {
+		input.DBSubnetGroupName = instance.SubnetGroup.DBSubnetGroupName
+	}
+
+	restoreResp, err := f.Svc.RestoreDBInstanceToPointInTime(input)
+	if err != nil {
+		return credentialsError(err)
+	}
+
+	return f.waitForRestore(ctx, instance, restoreResp.DBInstance)
+}
+
+func (f *CfRDSApi) waitForRestore(ctx context.Context, instance *DBInstance, dbInstance *rds.DBInstance) error {
+	if len(dbInstance.VpcSecurityGroups) == 0 {
+		return errors.New("Error: do not have any VPC security groups to associate with RDS instance")
+	}
+
+	secGroups := instance.SecGroups
+	if len(secGroups) == 0 {
+		instance.SecGroups = dbInstance.VpcSecurityGroups
+	}
+
+	instance.ARN = aws.StringValue(dbInstance.DBInstanceArn)
+	instance.ResourceID = aws.StringValue(dbInstance.DbiResourceId)
+	instance.Username = aws.StringValue(dbInstance.MasterUsername)
+	instance.DBName = aws.StringValue(dbInstance.DBName)
+	instance.Engine = aws.StringValue(dbInstance.Engine)
+	if instance.SubnetGroup == nil {
+		instance.SubnetGroup = dbInstance.DBSubnetGroup
+	}
+
+	err := f.Svc.WaitUntilDBInstanceAvailableWithContext(ctx, &rds.DescribeDBInstancesInput{
+		DBInstanceIdentifier: aws.String(instance.InstanceName),
+	}, f.waiterOptions(ctx)...)
+	if err != nil {
+		return contextError(ctx, err)
+	}
+
+	password, err := GeneratePassword(instance.Engine)
+	if err != nil {
+		return err
+	}
+
+	// RDS restores into the default security group of the VPC; the others
+	// can only be set once the instance is available.
+	input := &rds.ModifyDBInstanceInput{
+		DBInstanceIdentifier: aws.String(instance.InstanceName),
+		MasterUserPassword:   aws.String(password),
+		ApplyImmediately:     aws.Bool(true),
+	}
+	for _, secGroup := range secGroups {
+		input.VpcSecurityGroupIds = append(input.VpcSecurityGroupIds, secGroup.VpcSecurityGroupId)
+	}
+	_, err = f.Svc.ModifyDBInstance(input)
+	if err != nil {
+		return credentialsError(err)
+	}
+
+	return f.waitForPasswordReset(ctx, instance, password)
+}
@@ -0,0 +1,68 @@
+package api
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+)
+
+// ScaleInstance changes the class, storage and provisioned IOPS of the RDS
+// instance to those set on instance; zero values are left unchanged. The
+// pending modifications reported by RDS are stored on the instance.
+func (f *CfRDSApi) ScaleInstance(instance *DBInstance, applyImmediately bool) error {
+	input := &rds.ModifyDBInstanceInput{
+		DBInstanceIdentifier: aws.String(instance.InstanceName),
+		ApplyImmediately:     aws.Bool(applyImmediately),
+	}
+	if instance.InstanceClass != "" {
+		input.DBInstanceClass = aws.String(instance.InstanceClass)
+	}
+	if instance.Storage != 0 {
+		input.AllocatedStorage = aws.Int64(instance.Storage)
+	}
+	if instance.IOPS != 0 {
+		input.Iops = aws.Int64(instance.IOPS)
+	}
+
+	modifyDBInstanceResp, err := f.Svc.ModifyDBInstance(input)
+	if err != nil {
+		return credentialsError(err)
+	}
+	instance.PendingModifications = modifyDBInstanceResp.DBInstance.PendingModifiedValues
+
+	return nil
+}
+
+// WaitForScale polls until the instance is available and, if the changes are
+// applied immediately, no longer lists them as pending modifications.
+func (f *CfRDSApi) WaitForScale(ctx context.Context, instance *DBInstance, applyImmediately bool) error {
+	for {
+		err := f.Svc.WaitUntilDBInstanceAvailableWithContext(ctx, &rds.DescribeDBInstancesInput{
+			DBInstanceIdentifier: aws.String(instance.InstanceName),
+		}, f.waiterOptions(ctx)...)
+		if err != nil {
+			return contextError(ctx, err)
+		}
+
+		current, err := f.DescribeInstance(instance.InstanceName)
+		if err != nil {
+			return err
+		}
+		reportInstanceProgress(ctx, current)
+
+		if current.Status == "available" && (!applyImmediately || !scalePending(current.PendingModifications)) {
+			instance.PendingModifications = current.PendingModifications
+			return nil
+		}
+
+		err = f.pause(ctx)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func scalePending(pending *rds.PendingModifiedValues) bool {
+	return pending != nil && (pending.DBInstanceClass != nil || pending.AllocatedStorage != nil || pending.Iops != nil)
+}
@@ -0,0 +1,84 @@
+package api_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/seattle-beach/cf-cli-rds-plugin/api"
+	"github.com/seattle-beach/cf-cli-rds-plugin/api/fakes"
+)
+
+var _ = Describe("ScaleInstance", func() {
+	var fakeRDSSvc *fakes.FakeRDSService
+	var cfRDSApi *api.CfRDSApi
+	var instance *api.DBInstance
+
+	describeWithPending := func(pending *rds.PendingModifiedValues) *rds.DescribeDBInstancesOutput {
+		return &rds.DescribeDBInstancesOutput{
+			DBInstances: []*rds.DBInstance{{
+				DBInstanceIdentifier:  aws.String("name"),
+				DBInstanceStatus:      aws.String("available"),
+				PendingModifiedValues: pending,
+			}},
+		}
+	}
+
+	BeforeEach(func() {
+		fakeRDSSvc = &fakes.FakeRDSService{}
+		cfRDSApi = &api.CfRDSApi{
+			Svc:          fakeRDSSvc,
+			PollInterval: time.Millisecond,
+		}
+		instance = &api.DBInstance{
+			InstanceName:  "name",
+			InstanceClass: "db.m4.large",
+			Storage:       50,
+		}
+
+		fakeRDSSvc.ModifyDBInstanceReturns(&rds.ModifyDBInstanceOutput{
+			DBInstance: &rds.DBInstance{
+				PendingModifiedValues: &rds.PendingModifiedValues{
+					DBInstanceClass: aws.String("db.m4.large"),
+				},
+			},
+		}, nil)
+	})
+
+	It("only modifies the values that are set", func() {
+		err := cfRDSApi.ScaleInstance(instance, false)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeRDSSvc.ModifyDBInstanceArgsForCall(0)).To(Equal(&rds.ModifyDBInstanceInput{
+			DBInstanceIdentifier: aws.String("name"),
+			DBInstanceClass:      aws.String("db.m4.large"),
+			AllocatedStorage:     aws.Int64(50),
+			ApplyImmediately:     aws.Bool(false),
+		}))
+		Expect(instance.PendingModifications.DBInstanceClass).To(Equal(aws.String("db.m4.large")))
+	})
+
+	It("waits until applied changes are no longer pending", func() {
+		fakeRDSSvc.DescribeDBInstancesReturnsOnCall(0, describeWithPending(&rds.PendingModifiedValues{
+			DBInstanceClass: aws.String("db.m4.large"),
+		}), nil)
+		fakeRDSSvc.DescribeDBInstancesReturnsOnCall(1, describeWithPending(&rds.PendingModifiedValues{}), nil)
+
+		err := cfRDSApi.WaitForScale(context.Background(), instance, true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fakeRDSSvc.DescribeDBInstancesCallCount()).To(Equal(2))
+	})
+
+	It("only waits for the instance to be available when changes are deferred", func() {
+		fakeRDSSvc.DescribeDBInstancesReturns(describeWithPending(&rds.PendingModifiedValues{
+			DBInstanceClass: aws.String("db.m4.large"),
+		}), nil)
+
+		err := cfRDSApi.WaitForScale(context.Background(), instance, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fakeRDSSvc.DescribeDBInstancesCallCount()).To(Equal(1))
+	})
+})
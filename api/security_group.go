@@ -0,0 +1,125 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+type EC2Service interface {
+	CreateSecurityGroup(input *ec2.CreateSecurityGroupInput) (*ec2.CreateSecurityGroupOutput, error)
+	AuthorizeSecurityGroupIngress(input *ec2.AuthorizeSecurityGroupIngressInput) (*ec2.AuthorizeSecurityGroupIngressOutput, error)
+	CreateTags(input *ec2.CreateTagsInput) (*ec2.CreateTagsOutput, error)
+	DescribeSecurityGroups(input *ec2.DescribeSecurityGroupsInput) (*ec2.DescribeSecurityGroupsOutput, error)
+	DeleteSecurityGroup(input *ec2.DeleteSecurityGroupInput) (*ec2.DeleteSecurityGroupOutput, error)
+}
+
+// CreateSecurityGroup creates a security group in the VPC for the RDS
+// instance of the service that admits nothing but the CIDRs on port, and
+// returns its ID. The group carries the tags along with the service name, so
+// that DeleteSecurityGroups finds it again.
+func (f *CfRDSApi) CreateSecurityGroup(serviceName string, vpc string, port int64, cidrs []string, tags map[string]string) (string, error) {
+	createSecurityGroupResp, err := f.EC2.CreateSecurityGroup(&ec2.CreateSecurityGroupInput{
+		GroupName:   aws.String("cf-rds-" + serviceName),
+		Description: aws.String(fmt.Sprintf("Admits CF apps to the RDS instance of service %s", serviceName)),
+		VpcId:       aws.String(vpc),
+	})
+	if err != nil {
+		return "", credentialsError(err)
+	}
+	groupID := aws.StringValue(createSecurityGroupResp.GroupId)
+
+	err = f.setUpSecurityGroup(groupID, serviceName, port, cidrs, tags)
+	if err != nil {
+		f.EC2.DeleteSecurityGroup(&ec2.DeleteSecurityGroupInput{GroupId: aws.String(groupID)})
+		return "", err
+	}
+	return groupID, nil
+}
+
+func (f *CfRDSApi) setUpSecurityGroup(groupID string, serviceName string, port int64, cidrs []string, tags map[string]string) error {
+	ec2Tags := []*ec2.Tag{
+		{Key: aws.String("Name"), Value: aws.String("cf-rds-" + serviceName)},
+		{Key: aws.String(ServiceTag), Value: aws.String(serviceName)},
+	}
+	for _, key := range sortedKeys(tags) {
+		if key != ServiceTag {
+			ec2Tags = append(ec2Tags, &ec2.Tag{Key: aws.String(key), Value: aws.String(tags[key])})
+		}
+	}
+	_, err := f.EC2.CreateTags(&ec2.CreateTagsInput{
+		Resources: []*string{aws.String(groupID)},
+		Tags:      ec2Tags,
+	})
+	if err != nil {
+		return err
+	}
+
+	permission := &ec2.IpPermission{
+		IpProtocol: aws.String("tcp"),
+		FromPort:   aws.Int64(port),
+		ToPort:     aws.Int64(port),
+	}
+	for _, cidr := range cidrs {
+		permission.IpRanges = append(permission.IpRanges, &ec2.IpRange{CidrIp: aws.String(cidr)})
+	}
+	_, err = f.EC2.AuthorizeSecurityGroupIngress(&ec2.AuthorizeSecurityGroupIngressInput{
+		GroupId:       aws.String(groupID),
+		IpPermissions: []*ec2.IpPermission{permission},
+	})
+	return err
+}
+
+// DeleteSecurityGroup deletes the security group created for an RDS instance
+// that RDS then failed to create.
+func (f *CfRDSApi) DeleteSecurityGroup(groupID string) error {
+	_, err := f.EC2.DeleteSecurityGroup(&ec2.DeleteSecurityGroupInput{
+		GroupId: aws.String(groupID),
+	})
+	if err != nil {
+		return credentialsError(err)
+	}
+	return nil
+}
+
+// DeleteSecurityGroups deletes the security groups created for the RDS
+// instance of a service, which carry all of the tags, such as those of the
+// service and its CF space. EC2 releases the network interfaces of a deleted
+// instance with a delay, so groups still in use are retried every
+// PollInterval until ctx is done.
+func (f *CfRDSApi) DeleteSecurityGroups(ctx context.Context, tags map[string]string) error {
+	input := &ec2.DescribeSecurityGroupsInput{}
+	for _, key := range sortedKeys(tags) {
+		input.Filters = append(input.Filters, &ec2.Filter{
+			Name:   aws.String("tag:" + key),
+			Values: []*string{aws.String(tags[key])},
+		})
+	}
+	describeSecurityGroupsResp, err := f.EC2.DescribeSecurityGroups(input)
+	if err != nil {
+		return credentialsError(err)
+	}
+
+	for _, securityGroup := range describeSecurityGroupsResp.SecurityGroups {
+		for {
+			_, err = f.EC2.DeleteSecurityGroup(&ec2.DeleteSecurityGroupInput{
+				GroupId: securityGroup.GroupId,
+			})
+			if awsErr, ok := err.(awserr.Error); !ok || awsErr.Code() != "DependencyViolation" {
+				break
+			}
+
+			err = f.pause(ctx)
+			if err != nil {
+				return err
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/seattle-beach/cf-cli-rds-plugin/api"
+	"github.com/seattle-beach/cf-cli-rds-plugin/api/fakes"
+)
+
+var _ = Describe("security groups", func() {
+	var fakeEC2Svc *fakes.FakeEC2Service
+	var cfRDSApi *api.CfRDSApi
+
+	BeforeEach(func() {
+		fakeEC2Svc = &fakes.FakeEC2Service{}
+		cfRDSApi = &api.CfRDSApi{
+			Svc:          &fakes.FakeRDSService{},
+			EC2:          fakeEC2Svc,
+			PollInterval: time.Millisecond,
+		}
+	})
+
+	Describe("CreateSecurityGroup", func() {
+		BeforeEach(func() {
+			fakeEC2Svc.CreateSecurityGroupReturns(&ec2.CreateSecurityGroupOutput{GroupId: aws.String("sg-isolated")}, nil)
+		})
+
+		It("creates a tagged security group admitting only the CIDRs on the port", func() {
+			groupID, err := cfRDSApi.CreateSecurityGroup("name", "vpcid", 5432, []string{"203.0.113.0/24", "198.51.100.7/32"}, map[string]string{
+				"cf-rds-service": "name",
+				"cf-rds-space":   "dev",
+				"team":           "data",
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(groupID).To(Equal("sg-isolated"))
+
+			Expect(fakeEC2Svc.CreateSecurityGroupArgsForCall(0)).To(Equal(&ec2.CreateSecurityGroupInput{
+				GroupName:   aws.String("cf-rds-name"),
+				Description: aws.String("Admits CF apps to the RDS instance of service name"),
+				VpcId:       aws.String("vpcid"),
+			}))
+			Expect(fakeEC2Svc.CreateTagsArgsForCall(0)).To(Equal(&ec2.CreateTagsInput{
+				Resources: []*string{aws.String("sg-isolated")},
+				Tags: []*ec2.Tag{
+					{Key: aws.String("Name"), Value: aws.String("cf-rds-name")},
+					{Key: aws.String("cf-rds-service"), Value: aws.String("name")},
+					{Key: aws.String("cf-rds-space"), Value: aws.String("dev")},
+					{Key: aws.String("team"), Value: aws.String("data")},
+				},
+			}))
+			Expect(fakeEC2Svc.AuthorizeSecurityGroupIngressArgsForCall(0)).To(Equal(&ec2.AuthorizeSecurityGroupIngressInput{
+				GroupId: aws.String("sg-isolated"),
+				IpPermissions: []*ec2.IpPermission{{
+					IpProtocol: aws.String("tcp"),
+					FromPort:   aws.Int64(5432),
+					ToPort:     aws.Int64(5432),
+					IpRanges: []*ec2.IpRange{
+						{CidrIp: aws.String("203.0.113.0/24")},
+						{CidrIp: aws.String("198.51.100.7/32")},
+					},
+				}},
+			}))
+			Expect(fakeEC2Svc.DeleteSecurityGroupCallCount()).To(Equal(0))
+		})
+
+		It("deletes the security group again if the ingress rule cannot be added", func() {
+			fakeEC2Svc.AuthorizeSecurityGroupIngressReturns(nil, errors.New("InvalidParameterValue"))
+			_, err := cfRDSApi.CreateSecurityGroup("name", "vpcid", 5432, []string{"203.0.113.0/24"}, nil)
+			Expect(err).To(MatchError("InvalidParameterValue"))
+			Expect(fakeEC2Svc.DeleteSecurityGroupCallCount()).To(Equal(1))
+			Expect(fakeEC2Svc.DeleteSecurityGroupArgsForCall(0)).To(Equal(&ec2.DeleteSecurityGroupInput{
+				GroupId: aws.String("sg-isolated"),
+			}))
+		})
+
+		It("returns a helpful error without AWS credentials", func() {
+			fakeEC2Svc.CreateSecurityGroupReturns(nil, errors.New("NoCredentialProviders"))
+			_, err := cfRDSApi.CreateSecurityGroup("name", "vpcid", 5432, []string{"203.0.113.0/24"}, nil)
+			Expect(err).To(Equal(api.ErrNoCredentials))
+		})
+	})
+
+	Describe("DeleteSecurityGroup", func() {
+		It("deletes the security group", func() {
+			err := cfRDSApi.DeleteSecurityGroup("sg-isolated")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeEC2Svc.DeleteSecurityGroupArgsForCall(0)).To(Equal(&ec2.DeleteSecurityGroupInput{
+				GroupId: aws.String("sg-isolated"),
+			}))
+		})
+	})
+
+	Describe("DeleteSecurityGroups", func() {
+		var tags map[string]string
+
+		BeforeEach(func() {
+			tags = map[string]string{
+				"cf-rds-service":    "name",
+				"cf-rds-space-guid": "space-guid",
+			}
+			fakeEC2Svc.DescribeSecurityGroupsReturns(&ec2.DescribeSecurityGroupsOutput{
+				SecurityGroups: []*ec2.SecurityGroup{
+					{GroupId: aws.String("sg-isolated")},
+				},
+			}, nil)
+		})
+
+		It("deletes the security groups tagged with the service and its space", func() {
+			err := cfRDSApi.DeleteSecurityGroups(context.Background(), tags)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeEC2Svc.DescribeSecurityGroupsArgsForCall(0)).To(Equal(&ec2.DescribeSecurityGroupsInput{
+				Filters: []*ec2.Filter{
+					{
+						Name:   aws.String("tag:cf-rds-service"),
+						Values: []*string{aws.String("name")},
+					},
+					{
+						Name:   aws.String("tag:cf-rds-space-guid"),
+						Values: []*string{aws.String("space-guid")},
+					},
+				},
+			}))
+			Expect(fakeEC2Svc.DeleteSecurityGroupCallCount()).To(Equal(1))
+			Expect(fakeEC2Svc.DeleteSecurityGroupArgsForCall(0)).To(Equal(&ec2.DeleteSecurityGroupInput{
+				GroupId: aws.String("sg-isolated"),
+			}))
+		})
+
+		It("retries while the security group is still in use", func() {
+			fakeEC2Svc.DeleteSecurityGroupReturnsOnCall(0, nil, awserr.New("DependencyViolation", "resource has a dependent object", nil))
+			fakeEC2Svc.DeleteSecurityGroupReturnsOnCall(1, &ec2.DeleteSecurityGroupOutput{}, nil)
+
+			err := cfRDSApi.DeleteSecurityGroups(context.Background(), tags)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeEC2Svc.DeleteSecurityGroupCallCount()).To(Equal(2))
+		})
+
+		It("gives up retrying once the context is done", func() {
+			fakeEC2Svc.DeleteSecurityGroupReturns(nil, awserr.New("DependencyViolation", "resource has a dependent object", nil))
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			err := cfRDSApi.DeleteSecurityGroups(ctx, tags)
+			Expect(err).To(Equal(context.Canceled))
+		})
+
+		It("does nothing if no security group was created for the service", func() {
+			fakeEC2Svc.DescribeSecurityGroupsReturns(&ec2.DescribeSecurityGroupsOutput{}, nil)
+			err := cfRDSApi.DeleteSecurityGroups(context.Background(), tags)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeEC2Svc.DeleteSecurityGroupCallCount()).To(Equal(0))
+		})
+
+		It("returns other errors right away", func() {
+			fakeEC2Svc.DeleteSecurityGroupReturns(nil, errors.New("InvalidGroup.NotFound"))
+			err := cfRDSApi.DeleteSecurityGroups(context.Background(), tags)
+			Expect(err).To(MatchError("InvalidGroup.NotFound"))
+			Expect(fakeEC2Svc.DeleteSecurityGroupCallCount()).To(Equal(1))
+		})
+	})
+})
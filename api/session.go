@@ -0,0 +1,47 @@
+package api
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/rds"
+)
+
+// DefaultRegion is used when no region is given on the command line, in the
+// environment or in the shared AWS config.
+const DefaultRegion = endpoints.UsEast1RegionID
+
+// NewCfRDSApi returns a CfRDSApi talking to RDS in the given region. An empty
+// region falls back to AWS_REGION, AWS_DEFAULT_REGION, the region of the
+// shared config profile and finally DefaultRegion.
+func NewCfRDSApi(region string) (*CfRDSApi, error) {
+	config := aws.Config{}
+	if region != "" {
+		config.Region = aws.String(region)
+	}
+
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config:            config,
+		SharedConfigState: session.SharedConfigEnable,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.StringValue(sess.Config.Region) == "" {
+		sess.Config.Region = aws.String(DefaultRegion)
+	}
+
+	return &CfRDSApi{
+		Svc:          rds.New(sess),
+		EC2:          ec2.New(sess),
+		IAM:          iam.New(sess),
+		Region:       *sess.Config.Region,
+		PollInterval: 30 * time.Second,
+		Credentials:  sess.Config.Credentials,
+	}, nil
+}
@@ -0,0 +1,60 @@
+package api_test
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/seattle-beach/cf-cli-rds-plugin/api"
+)
+
+var _ = Describe("NewCfRDSApi", func() {
+	var savedEnv map[string]string
+	envKeys := []string{"AWS_REGION", "AWS_DEFAULT_REGION", "AWS_PROFILE", "AWS_CONFIG_FILE", "AWS_SDK_LOAD_CONFIG"}
+
+	BeforeEach(func() {
+		savedEnv = map[string]string{}
+		for _, key := range envKeys {
+			savedEnv[key] = os.Getenv(key)
+			os.Unsetenv(key)
+		}
+		os.Setenv("AWS_CONFIG_FILE", "/nonexistent")
+	})
+
+	AfterEach(func() {
+		for key, value := range savedEnv {
+			if value == "" {
+				os.Unsetenv(key)
+			} else {
+				os.Setenv(key, value)
+			}
+		}
+	})
+
+	It("uses the given region", func() {
+		os.Setenv("AWS_REGION", "eu-west-1")
+		cfRDSApi, err := api.NewCfRDSApi("ap-southeast-2")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfRDSApi.Region).To(Equal("ap-southeast-2"))
+	})
+
+	It("falls back to AWS_REGION", func() {
+		os.Setenv("AWS_REGION", "eu-west-1")
+		cfRDSApi, err := api.NewCfRDSApi("")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfRDSApi.Region).To(Equal("eu-west-1"))
+	})
+
+	It("falls back to AWS_DEFAULT_REGION", func() {
+		os.Setenv("AWS_DEFAULT_REGION", "ap-southeast-2")
+		cfRDSApi, err := api.NewCfRDSApi("")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfRDSApi.Region).To(Equal("ap-southeast-2"))
+	})
+
+	It("defaults to us-east-1", func() {
+		cfRDSApi, err := api.NewCfRDSApi("")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfRDSApi.Region).To(Equal("us-east-1"))
+	})
+})
@@ -0,0 +1,160 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/rds"
+)
+
+type DBSnapshot struct {
+	Name         string
+	InstanceName string
+	ARN          string
+	Status       string
+	Type         string
+	Engine       string
+	Storage      int64
+	Created      time.Time
+	VPC          string
+}
+
+func newDBSnapshot(dbSnapshot *rds.DBSnapshot) *DBSnapshot {
+	return &DBSnapshot{
+		Name:         aws.StringValue(dbSnapshot.DBSnapshotIdentifier),
+		InstanceName: aws.StringValue(dbSnapshot.DBInstanceIdentifier),
+		ARN:          aws.StringValue(dbSnapshot.DBSnapshotArn),
+		Status:       aws.StringValue(dbSnapshot.Status),
+		Type:         aws.StringValue(dbSnapshot.SnapshotType),
+		Engine:       aws.StringValue(dbSnapshot.Engine),
+		Storage:      aws.Int64Value(dbSnapshot.AllocatedStorage),
+		Created:      aws.TimeValue(dbSnapshot.SnapshotCreateTime),
+		VPC:          aws.StringValue(dbSnapshot.VpcId),
+	}
+}
+
+// CreateSnapshot takes a manual DB snapshot of the RDS instance. The SDK has
+// no waiter for DB snapshots, so it polls DescribeDBSnapshots until the
+// snapshot is available. Members of Aurora DB clusters are refused, as their
+// data lives in the cluster.
+func (f *CfRDSApi) CreateSnapshot(ctx context.Context, instanceName string, snapshotName string) error {
+	instance, err := f.DescribeInstance(instanceName)
+	if err != nil {
+		return err
+	}
+	if instance.ClusterName != "" {
+		return fmt.Errorf("Error: RDS instance %s belongs to DB cluster %s, whose snapshots are not supported", instanceName, instance.ClusterName)
+	}
+
+	_, err = f.Svc.CreateDBSnapshot(&rds.CreateDBSnapshotInput{
+		DBInstanceIdentifier: aws.String(instanceName),
+		DBSnapshotIdentifier: aws.String(snapshotName),
+	})
+	if err != nil {
+		return credentialsError(err)
+	}
+
+	return f.waitForSnapshot(ctx, snapshotName)
+}
+
+func (f *CfRDSApi) waitForSnapshot(ctx context.Context, snapshotName string) error {
+	for {
+		describeDBSnapshotsResp, err := f.Svc.DescribeDBSnapshots(&rds.DescribeDBSnapshotsInput{
+			DBSnapshotIdentifier: aws.String(snapshotName),
+		})
+		if err != nil {
+			return credentialsError(err)
+		}
+
+		if len(describeDBSnapshotsResp.DBSnapshots) == 0 {
+			return fmt.Errorf("Could not find DB snapshot %s", snapshotName)
+		}
+
+		dbSnapshot := describeDBSnapshotsResp.DBSnapshots[0]
+		status := aws.StringValue(dbSnapshot.Status)
+		percent := int64(-1)
+		if dbSnapshot.PercentProgress != nil {
+			percent = *dbSnapshot.PercentProgress
+		}
+		ReportProgress(ctx, "DB snapshot", snapshotName, status, percent)
+
+		switch status {
+		case "available":
+			return nil
+		case "failed", "deleting":
+			return fmt.Errorf("DB snapshot %s is %s", snapshotName, status)
+		}
+
+		err = f.pause(ctx)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// ListSnapshots returns the DB snapshots of the RDS instance, following
+// DescribeDBSnapshots markers until all pages have been read.
+func (f *CfRDSApi) ListSnapshots(instanceName string) ([]*DBSnapshot, error) {
+	snapshots := []*DBSnapshot{}
+	input := &rds.DescribeDBSnapshotsInput{
+		DBInstanceIdentifier: aws.String(instanceName),
+	}
+
+	for {
+		describeDBSnapshotsResp, err := f.Svc.DescribeDBSnapshots(input)
+		if err != nil {
+			return nil, credentialsError(err)
+		}
+
+		for _, dbSnapshot := range describeDBSnapshotsResp.DBSnapshots {
+			snapshots = append(snapshots, newDBSnapshot(dbSnapshot))
+		}
+
+		if aws.StringValue(describeDBSnapshotsResp.Marker) == "" {
+			return snapshots, nil
+		}
+		input.Marker = describeDBSnapshotsResp.Marker
+	}
+}
+
+// DescribeSnapshot returns the DB snapshot along with the RDS instance it was
+// taken of, which is nil if that instance has since been deleted.
+func (f *CfRDSApi) DescribeSnapshot(snapshotName string) (*DBSnapshot, *DBInstance, error) {
+	describeDBSnapshotsResp, err := f.Svc.DescribeDBSnapshots(&rds.DescribeDBSnapshotsInput{
+		DBSnapshotIdentifier: aws.String(snapshotName),
+	})
+	if err != nil {
+		return nil, nil, credentialsError(err)
+	}
+	if len(describeDBSnapshotsResp.DBSnapshots) == 0 {
+		return nil, nil, fmt.Errorf("Could not find DB snapshot %s", snapshotName)
+	}
+	snapshot := newDBSnapshot(describeDBSnapshotsResp.DBSnapshots[0])
+
+	describeDBInstancesResp, err := f.Svc.DescribeDBInstances(&rds.DescribeDBInstancesInput{
+		DBInstanceIdentifier: aws.String(snapshot.InstanceName),
+	})
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == rds.ErrCodeDBInstanceNotFoundFault {
+		return snapshot, nil, nil
+	}
+	if err != nil {
+		return nil, nil, credentialsError(err)
+	}
+	if len(describeDBInstancesResp.DBInstances) == 0 {
+		return snapshot, nil, nil
+	}
+	return snapshot, newDBInstance(describeDBInstancesResp.DBInstances[0]), nil
+}
+
+func (f *CfRDSApi) DeleteSnapshot(snapshotName string) error {
+	_, err := f.Svc.DeleteDBSnapshot(&rds.DeleteDBSnapshotInput{
+		DBSnapshotIdentifier: aws.String(snapshotName),
+	})
+	if err != nil {
+		return credentialsError(err)
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/rds"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/seattle-beach/cf-cli-rds-plugin/api"
+	"github.com/seattle-beach/cf-cli-rds-plugin/api/fakes"
+)
+
+var _ = Describe("Snapshots", func() {
+	var fakeRDSSvc *fakes.FakeRDSService
+	var cfRDSApi *api.CfRDSApi
+
+	BeforeEach(func() {
+		fakeRDSSvc = &fakes.FakeRDSService{}
+		cfRDSApi = &api.CfRDSApi{
+			Svc:          fakeRDSSvc,
+			PollInterval: time.Millisecond,
+		}
+	})
+
+	snapshotWithStatus := func(status string) *rds.DescribeDBSnapshotsOutput {
+		return &rds.DescribeDBSnapshotsOutput{
+			DBSnapshots: []*rds.DBSnapshot{{
+				DBSnapshotIdentifier: aws.String("before-upgrade"),
+				Status:               aws.String(status),
+			}},
+		}
+	}
+
+	Describe("CreateSnapshot", func() {
+		BeforeEach(func() {
+			fakeRDSSvc.DescribeDBInstancesReturns(&rds.DescribeDBInstancesOutput{
+				DBInstances: []*rds.DBInstance{{
+					DBInstanceIdentifier: aws.String("name"),
+				}},
+			}, nil)
+		})
+
+		It("creates a snapshot and polls until it is available", func() {
+			fakeRDSSvc.DescribeDBSnapshotsReturnsOnCall(0, snapshotWithStatus("creating"), nil)
+			fakeRDSSvc.DescribeDBSnapshotsReturnsOnCall(1, snapshotWithStatus("available"), nil)
+
+			err := cfRDSApi.CreateSnapshot(context.Background(), "name", "before-upgrade")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeRDSSvc.CreateDBSnapshotArgsForCall(0)).To(Equal(&rds.CreateDBSnapshotInput{
+				DBInstanceIdentifier: aws.String("name"),
+				DBSnapshotIdentifier: aws.String("before-upgrade"),
+			}))
+			Expect(fakeRDSSvc.DescribeDBSnapshotsCallCount()).To(Equal(2))
+			Expect(fakeRDSSvc.DescribeDBSnapshotsArgsForCall(1)).To(Equal(&rds.DescribeDBSnapshotsInput{
+				DBSnapshotIdentifier: aws.String("before-upgrade"),
+			}))
+		})
+
+		It("reports a failed snapshot", func() {
+			fakeRDSSvc.DescribeDBSnapshotsReturns(snapshotWithStatus("failed"), nil)
+
+			err := cfRDSApi.CreateSnapshot(context.Background(), "name", "before-upgrade")
+			Expect(err).To(MatchError("DB snapshot before-upgrade is failed"))
+		})
+
+		It("returns a helpful error if the snapshot cannot be polled without AWS credentials", func() {
+			fakeRDSSvc.DescribeDBSnapshotsReturns(nil, errors.New("NoCredentialProviders: no valid providers in chain"))
+
+			err := cfRDSApi.CreateSnapshot(context.Background(), "name", "before-upgrade")
+			Expect(err).To(Equal(api.ErrNoCredentials))
+		})
+
+		It("refuses members of Aurora DB clusters", func() {
+			fakeRDSSvc.DescribeDBInstancesReturns(&rds.DescribeDBInstancesOutput{
+				DBInstances: []*rds.DBInstance{{
+					DBInstanceIdentifier: aws.String("name"),
+					DBClusterIdentifier:  aws.String("name"),
+				}},
+			}, nil)
+
+			err := cfRDSApi.CreateSnapshot(context.Background(), "name", "before-upgrade")
+			Expect(err).To(MatchError("Error: RDS instance name belongs to DB cluster name, whose snapshots are not supported"))
+			Expect(fakeRDSSvc.CreateDBSnapshotCallCount()).To(Equal(0))
+		})
+
+		It("returns the error if the snapshot cannot be created", func() {
+			fakeRDSSvc.CreateDBSnapshotReturns(nil, errors.New("NoCredentialProviders: no valid providers in chain"))
+
+			err := cfRDSApi.CreateSnapshot(context.Background(), "name", "before-upgrade")
+			Expect(err).To(MatchError("No valid AWS credentials found. Please see this document for help configuring the AWS SDK: https://github.com/aws/aws-sdk-go#configuring-credentials"))
+		})
+	})
+
+	Describe("ListSnapshots", func() {
+		It("follows markers and converts the AWS snapshots", func() {
+			created := time.Date(2017, 10, 1, 12, 0, 0, 0, time.UTC)
+			fakeRDSSvc.DescribeDBSnapshotsReturnsOnCall(0, &rds.DescribeDBSnapshotsOutput{
+				DBSnapshots: []*rds.DBSnapshot{{
+					DBSnapshotIdentifier: aws.String("first"),
+					DBInstanceIdentifier: aws.String("name"),
+					SnapshotType:         aws.String("manual"),
+					Status:               aws.String("available"),
+					Engine:               aws.String("postgres"),
+					AllocatedStorage:     aws.Int64(20),
+					SnapshotCreateTime:   aws.Time(created),
+				}},
+				Marker: aws.String("page-2"),
+			}, nil)
+			fakeRDSSvc.DescribeDBSnapshotsReturnsOnCall(1, &rds.DescribeDBSnapshotsOutput{
+				DBSnapshots: []*rds.DBSnapshot{{
+					DBSnapshotIdentifier: aws.String("second"),
+				}},
+			}, nil)
+
+			snapshots, err := cfRDSApi.ListSnapshots("name")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeRDSSvc.DescribeDBSnapshotsArgsForCall(1)).To(Equal(&rds.DescribeDBSnapshotsInput{
+				DBInstanceIdentifier: aws.String("name"),
+				Marker:               aws.String("page-2"),
+			}))
+			Expect(snapshots).To(HaveLen(2))
+			Expect(snapshots[0]).To(Equal(&api.DBSnapshot{
+				Name:         "first",
+				InstanceName: "name",
+				Type:         "manual",
+				Status:       "available",
+				Engine:       "postgres",
+				Storage:      20,
+				Created:      created,
+			}))
+		})
+	})
+
+	Describe("DescribeSnapshot", func() {
+		BeforeEach(func() {
+			fakeRDSSvc.DescribeDBSnapshotsReturns(&rds.DescribeDBSnapshotsOutput{
+				DBSnapshots: []*rds.DBSnapshot{{
+					DBSnapshotIdentifier: aws.String("before-upgrade"),
+					DBInstanceIdentifier: aws.String("name"),
+					VpcId:                aws.String("vpcid"),
+				}},
+			}, nil)
+		})
+
+		It("returns the snapshot and the instance it was taken of", func() {
+			fakeRDSSvc.DescribeDBInstancesReturns(&rds.DescribeDBInstancesOutput{
+				DBInstances: []*rds.DBInstance{{
+					DBInstanceIdentifier: aws.String("name"),
+					PubliclyAccessible:   aws.Bool(true),
+				}},
+			}, nil)
+
+			snapshot, source, err := cfRDSApi.DescribeSnapshot("before-upgrade")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(snapshot.InstanceName).To(Equal("name"))
+			Expect(snapshot.VPC).To(Equal("vpcid"))
+			Expect(source.InstanceName).To(Equal("name"))
+			Expect(source.PubliclyAccessible).To(BeTrue())
+			Expect(fakeRDSSvc.DescribeDBInstancesArgsForCall(0).DBInstanceIdentifier).To(Equal(aws.String("name")))
+		})
+
+		It("returns no instance once the instance has been deleted", func() {
+			fakeRDSSvc.DescribeDBInstancesReturns(nil, awserr.New(rds.ErrCodeDBInstanceNotFoundFault, "DBInstance name not found", nil))
+
+			snapshot, source, err := cfRDSApi.DescribeSnapshot("before-upgrade")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(snapshot.Name).To(Equal("before-upgrade"))
+			Expect(source).To(BeNil())
+		})
+
+		It("returns an error for a missing snapshot", func() {
+			fakeRDSSvc.DescribeDBSnapshotsReturns(&rds.DescribeDBSnapshotsOutput{}, nil)
+			_, _, err := cfRDSApi.DescribeSnapshot("before-upgrade")
+			Expect(err).To(MatchError("Could not find DB snapshot before-upgrade"))
+		})
+	})
+
+	Describe("DeleteSnapshot", func() {
+		It("deletes the snapshot", func() {
+			fakeRDSSvc.DeleteDBSnapshotReturns(&rds.DeleteDBSnapshotOutput{}, nil)
+			Expect(cfRDSApi.DeleteSnapshot("before-upgrade")).To(Succeed())
+			Expect(fakeRDSSvc.DeleteDBSnapshotArgsForCall(0)).To(Equal(&rds.DeleteDBSnapshotInput{
+				DBSnapshotIdentifier: aws.String("before-upgrade"),
+			}))
+		})
+	})
+})
@@ -0,0 +1,141 @@
+package api
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/rds"
+)
+
+// TagPrefix starts the keys of the tags the plugin sets on the resources it
+// creates.
+const TagPrefix = "cf-rds-"
+
+// The tags tracing a resource back to the service, and the CF space, it was
+// created for.
+const (
+	ServiceTag       = TagPrefix + "service"
+	APIEndpointTag   = TagPrefix + "api-endpoint"
+	OrgTag           = TagPrefix + "org"
+	OrgGUIDTag       = TagPrefix + "org-guid"
+	SpaceTag         = TagPrefix + "space"
+	SpaceGUIDTag     = TagPrefix + "space-guid"
+	PluginVersionTag = TagPrefix + "plugin-version"
+)
+
+// IAMRoleTag names the IAM role of an RDS instance created with --iam-auth,
+// so that the DB user of the role can be set up again if creating the
+// instance was cut short.
+const IAMRoleTag = TagPrefix + "iam-role"
+
+// FindInstance returns the RDS instance carrying all of the tags, or nil if
+// there is none. The instance named instanceName is tried first; only if it
+// is missing or tagged otherwise are the other instances looked through. It
+// is an error if none carries the tags but the instance named instanceName
+// was tagged by the plugin for another service or CF space, so that it is not
+// mistaken for an instance created before the plugin tagged them.
+func (f *CfRDSApi) FindInstance(instanceName string, tags map[string]string) (*DBInstance, error) {
+	taggedOtherwise := false
+	describeDBInstancesResp, err := f.Svc.DescribeDBInstances(&rds.DescribeDBInstancesInput{
+		DBInstanceIdentifier: aws.String(instanceName),
+	})
+	awsErr, ok := err.(awserr.Error)
+	if err != nil && !(ok && awsErr.Code() == rds.ErrCodeDBInstanceNotFoundFault) {
+		return nil, credentialsError(err)
+	}
+	if err == nil && len(describeDBInstancesResp.DBInstances) > 0 {
+		instance := newDBInstance(describeDBInstancesResp.DBInstances[0])
+		instance.Tags, err = f.listTags(instance.ARN)
+		if err != nil {
+			return nil, err
+		}
+		if matchesTags(instance.Tags, tags) {
+			return instance, nil
+		}
+		taggedOtherwise = HasPluginTags(instance.Tags)
+	}
+
+	instances, err := f.ListInstances()
+	if err != nil {
+		return nil, err
+	}
+	for _, instance := range instances {
+		if instance.InstanceName != instanceName && matchesTags(instance.Tags, tags) {
+			return instance, nil
+		}
+	}
+	if taggedOtherwise {
+		return nil, fmt.Errorf("Error: there is no RDS instance for service %s in this space; RDS instance %s belongs to another service or CF space", tags[ServiceTag], instanceName)
+	}
+	return nil, nil
+}
+
+// HasPluginTags reports whether the tags include any the plugin sets on the
+// resources it creates.
+func HasPluginTags(tags map[string]string) bool {
+	for key := range tags {
+		if strings.HasPrefix(key, TagPrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// listTags returns the tags of the RDS resource, keyed by name.
+func (f *CfRDSApi) listTags(arn string) (map[string]string, error) {
+	listTagsResp, err := f.Svc.ListTagsForResource(&rds.ListTagsForResourceInput{
+		ResourceName: aws.String(arn),
+	})
+	if err != nil {
+		return nil, credentialsError(err)
+	}
+
+	tags := map[string]string{}
+	for _, tag := range listTagsResp.TagList {
+		tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	return tags, nil
+}
+
+// hasTags reports whether the resource carries all of the tags.
+func (f *CfRDSApi) hasTags(arn string, tags map[string]string) (bool, error) {
+	resourceTags, err := f.listTags(arn)
+	if err != nil {
+		return false, err
+	}
+	return matchesTags(resourceTags, tags), nil
+}
+
+func matchesTags(resourceTags map[string]string, tags map[string]string) bool {
+	for key, value := range tags {
+		if resourceValue, ok := resourceTags[key]; !ok || resourceValue != value {
+			return false
+		}
+	}
+	return true
+}
+
+// rdsTags converts tags to RDS tags, ordered by key. It returns nil if there
+// are no tags.
+func rdsTags(tags map[string]string) []*rds.Tag {
+	var rdsTags []*rds.Tag
+	for _, key := range sortedKeys(tags) {
+		rdsTags = append(rdsTags, &rds.Tag{
+			Key:   aws.String(key),
+			Value: aws.String(tags[key]),
+		})
+	}
+	return rdsTags
+}
+
+func sortedKeys(tags map[string]string) []string {
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,175 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/rds"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/seattle-beach/cf-cli-rds-plugin/api"
+	"github.com/seattle-beach/cf-cli-rds-plugin/api/fakes"
+)
+
+var _ = Describe("tags", func() {
+	var fakeRDSSvc *fakes.FakeRDSService
+	var cfRDSApi *api.CfRDSApi
+	var tagsByARN map[string][]*rds.Tag
+	var spaceTags map[string]string
+
+	BeforeEach(func() {
+		fakeRDSSvc = &fakes.FakeRDSService{}
+		cfRDSApi = &api.CfRDSApi{
+			Svc: fakeRDSSvc,
+		}
+
+		tagsByARN = map[string][]*rds.Tag{}
+		fakeRDSSvc.ListTagsForResourceStub = func(input *rds.ListTagsForResourceInput) (*rds.ListTagsForResourceOutput, error) {
+			return &rds.ListTagsForResourceOutput{TagList: tagsByARN[aws.StringValue(input.ResourceName)]}, nil
+		}
+		spaceTags = map[string]string{
+			"cf-rds-service":    "name",
+			"cf-rds-space-guid": "space-guid",
+		}
+	})
+
+	Describe("FindInstance", func() {
+		It("returns the instance named after the service if it carries the tags", func() {
+			fakeRDSSvc.DescribeDBInstancesReturns(&rds.DescribeDBInstancesOutput{
+				DBInstances: []*rds.DBInstance{{
+					DBInstanceIdentifier: aws.String("name"),
+					DBInstanceArn:        aws.String("arn:aws:rds:us-east-1:10101010:db:name"),
+				}},
+			}, nil)
+			tagsByARN["arn:aws:rds:us-east-1:10101010:db:name"] = []*rds.Tag{
+				{Key: aws.String("cf-rds-service"), Value: aws.String("name")},
+				{Key: aws.String("cf-rds-space-guid"), Value: aws.String("space-guid")},
+				{Key: aws.String("team"), Value: aws.String("data")},
+			}
+
+			instance, err := cfRDSApi.FindInstance("name", spaceTags)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(instance.InstanceName).To(Equal("name"))
+			Expect(instance.Tags).To(HaveKeyWithValue("team", "data"))
+
+			Expect(fakeRDSSvc.DescribeDBInstancesCallCount()).To(Equal(1))
+			Expect(fakeRDSSvc.DescribeDBInstancesArgsForCall(0)).To(Equal(&rds.DescribeDBInstancesInput{
+				DBInstanceIdentifier: aws.String("name"),
+			}))
+		})
+
+		It("looks through the other instances if the named one belongs to another space", func() {
+			fakeRDSSvc.DescribeDBInstancesStub = func(input *rds.DescribeDBInstancesInput) (*rds.DescribeDBInstancesOutput, error) {
+				if input.DBInstanceIdentifier != nil {
+					return &rds.DescribeDBInstancesOutput{
+						DBInstances: []*rds.DBInstance{{
+							DBInstanceIdentifier: aws.String("name"),
+							DBInstanceArn:        aws.String("arn:aws:rds:us-east-1:10101010:db:name"),
+						}},
+					}, nil
+				}
+				return &rds.DescribeDBInstancesOutput{
+					DBInstances: []*rds.DBInstance{
+						{DBInstanceIdentifier: aws.String("name"), DBInstanceArn: aws.String("arn:aws:rds:us-east-1:10101010:db:name")},
+						{DBInstanceIdentifier: aws.String("name-dev"), DBInstanceArn: aws.String("arn:aws:rds:us-east-1:10101010:db:name-dev")},
+					},
+				}, nil
+			}
+			tagsByARN["arn:aws:rds:us-east-1:10101010:db:name"] = []*rds.Tag{
+				{Key: aws.String("cf-rds-service"), Value: aws.String("name")},
+				{Key: aws.String("cf-rds-space-guid"), Value: aws.String("other-space-guid")},
+			}
+			tagsByARN["arn:aws:rds:us-east-1:10101010:db:name-dev"] = []*rds.Tag{
+				{Key: aws.String("cf-rds-service"), Value: aws.String("name")},
+				{Key: aws.String("cf-rds-space-guid"), Value: aws.String("space-guid")},
+			}
+
+			instance, err := cfRDSApi.FindInstance("name", spaceTags)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(instance.InstanceName).To(Equal("name-dev"))
+		})
+
+		It("looks through the other instances if there is none named after the service", func() {
+			fakeRDSSvc.DescribeDBInstancesStub = func(input *rds.DescribeDBInstancesInput) (*rds.DescribeDBInstancesOutput, error) {
+				if input.DBInstanceIdentifier != nil {
+					return nil, awserr.New(rds.ErrCodeDBInstanceNotFoundFault, "DBInstance name not found", nil)
+				}
+				return &rds.DescribeDBInstancesOutput{
+					DBInstances: []*rds.DBInstance{
+						{DBInstanceIdentifier: aws.String("renamed"), DBInstanceArn: aws.String("arn:aws:rds:us-east-1:10101010:db:renamed")},
+					},
+				}, nil
+			}
+			tagsByARN["arn:aws:rds:us-east-1:10101010:db:renamed"] = []*rds.Tag{
+				{Key: aws.String("cf-rds-service"), Value: aws.String("name")},
+				{Key: aws.String("cf-rds-space-guid"), Value: aws.String("space-guid")},
+			}
+
+			instance, err := cfRDSApi.FindInstance("name", spaceTags)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(instance.InstanceName).To(Equal("renamed"))
+		})
+
+		It("returns an error if the instance named after the service belongs to another space", func() {
+			fakeRDSSvc.DescribeDBInstancesReturns(&rds.DescribeDBInstancesOutput{
+				DBInstances: []*rds.DBInstance{{
+					DBInstanceIdentifier: aws.String("name"),
+					DBInstanceArn:        aws.String("arn:aws:rds:us-east-1:10101010:db:name"),
+				}},
+			}, nil)
+			tagsByARN["arn:aws:rds:us-east-1:10101010:db:name"] = []*rds.Tag{
+				{Key: aws.String("cf-rds-service"), Value: aws.String("name")},
+				{Key: aws.String("cf-rds-space-guid"), Value: aws.String("other-space-guid")},
+			}
+
+			instance, err := cfRDSApi.FindInstance("name", spaceTags)
+			Expect(err).To(MatchError("Error: there is no RDS instance for service name in this space; RDS instance name belongs to another service or CF space"))
+			Expect(instance).To(BeNil())
+		})
+
+		It("returns nil if no instance carries the tags", func() {
+			fakeRDSSvc.DescribeDBInstancesReturns(&rds.DescribeDBInstancesOutput{
+				DBInstances: []*rds.DBInstance{{
+					DBInstanceIdentifier: aws.String("name"),
+				}},
+			}, nil)
+
+			instance, err := cfRDSApi.FindInstance("name", spaceTags)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(instance).To(BeNil())
+		})
+
+		It("returns other errors", func() {
+			fakeRDSSvc.DescribeDBInstancesReturns(nil, errors.New("NoCredentialProviders"))
+			_, err := cfRDSApi.FindInstance("name", spaceTags)
+			Expect(err).To(Equal(api.ErrNoCredentials))
+		})
+	})
+
+	Describe("CreateInstance", func() {
+		It("tags the RDS instance, ordered by key", func() {
+			fakeRDSSvc.CreateDBInstanceReturns(nil, errors.New("InstanceQuotaExceeded"))
+
+			err := cfRDSApi.CreateInstance(context.Background(), &api.DBInstance{
+				InstanceName: "name",
+				Engine:       "postgres",
+				Username:     "root",
+				SubnetGroup:  &rds.DBSubnetGroup{DBSubnetGroupName: aws.String("default-vpc-vpcid")},
+				Tags: map[string]string{
+					"team":              "data",
+					"cf-rds-service":    "name",
+					"cf-rds-space-guid": "space-guid",
+				},
+			})
+			Expect(err).To(MatchError("InstanceQuotaExceeded"))
+
+			Expect(fakeRDSSvc.CreateDBInstanceArgsForCall(0).Tags).To(Equal([]*rds.Tag{
+				{Key: aws.String("cf-rds-service"), Value: aws.String("name")},
+				{Key: aws.String("cf-rds-space-guid"), Value: aws.String("space-guid")},
+				{Key: aws.String("team"), Value: aws.String("data")},
+			}))
+		})
+	})
+})
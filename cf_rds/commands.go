@@ -0,0 +1,322 @@
+package cf_rds
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/plugin"
+	"github.com/jessevdk/go-flags"
+)
+
+// command is an entry of the registry that drives both GetMetadata and the
+// dispatch in Run. Its usage and the documentation of its flags are generated
+// from the go-flags tags of its options struct.
+type command struct {
+	name     string
+	helpText string
+	// arguments names the positional arguments, e.g. SERVICE_NAME.
+	arguments string
+	// exclusive lists the long names of the flags of which exactly one must
+	// be given. The usage shows them as a group, e.g. (--a | --b NAME).
+	exclusive []string
+	// options returns a new options struct of the command.
+	options func() interface{}
+	run     func(c *BasicPlugin, cliConnection plugin.CliConnection, args []string) error
+}
+
+var commands = []command{
+	{
+		name:      "aws-rds-register",
+		helpText:  "command to register existing RDS instance as a service with CF",
+		arguments: "SERVICE_NAME",
+		options:   func() interface{} { return &AwsRdsRegisterOptions{} },
+		run:       (*BasicPlugin).AwsRdsRegisterRun,
+	},
+	{
+		name:      "aws-rds-create",
+		helpText:  "command to create an RDS instance and register it as a service with CF",
+		arguments: "SERVICE_NAME",
+		options:   func() interface{} { return &AwsRdsCreateOptions{} },
+		run:       (*BasicPlugin).AwsRdsCreateRun,
+	},
+	{
+		name:      "aws-rds-refresh",
+		helpText:  "command to update an existing RDS instance and register it as a service with CF (used in case the user quits rds-create command before the instance is fully available)",
+		arguments: "SERVICE_NAME",
+		options:   func() interface{} { return &AwsRdsRefreshOptions{} },
+		run:       (*BasicPlugin).AwsRdsRefreshRun,
+	},
+	{
+		name:      "aws-rds-delete",
+		helpText:  "command to delete an RDS instance and the user-provided service registered for it",
+		arguments: "SERVICE_NAME",
+		exclusive: []string{"skip-final-snapshot", "final-snapshot"},
+		options:   func() interface{} { return &AwsRdsDeleteOptions{} },
+		run:       (*BasicPlugin).AwsRdsDeleteRun,
+	},
+	{
+		name:     "aws-rds-list",
+		helpText: "command to list RDS instances and the user-provided services registered for them",
+		options:  func() interface{} { return &AwsRdsListOptions{} },
+		run:      (*BasicPlugin).AwsRdsListRun,
+	},
+	{
+		name:      "aws-rds-info",
+		helpText:  "command to show details of the RDS instance registered as a service with CF",
+		arguments: "SERVICE_NAME",
+		options:   func() interface{} { return &AwsRdsInfoOptions{} },
+		run:       (*BasicPlugin).AwsRdsInfoRun,
+	},
+	{
+		name:      "aws-rds-rotate-credentials",
+		helpText:  "command to change the master password of an RDS instance and update its user-provided service",
+		arguments: "SERVICE_NAME",
+		options:   func() interface{} { return &AwsRdsRotateCredentialsOptions{} },
+		run:       (*BasicPlugin).AwsRdsRotateCredentialsRun,
+	},
+	{
+		name:      "aws-rds-snapshot",
+		helpText:  "command to take a DB snapshot of the RDS instance registered as a service with CF",
+		arguments: "SERVICE_NAME",
+		options:   func() interface{} { return &AwsRdsSnapshotOptions{} },
+		run:       (*BasicPlugin).AwsRdsSnapshotRun,
+	},
+	{
+		name:      "aws-rds-snapshots",
+		helpText:  "command to list the DB snapshots of the RDS instance registered as a service with CF",
+		arguments: "SERVICE_NAME",
+		options:   func() interface{} { return &AwsRdsSnapshotsOptions{} },
+		run:       (*BasicPlugin).AwsRdsSnapshotsRun,
+	},
+	{
+		name:      "aws-rds-delete-snapshot",
+		helpText:  "command to delete a DB snapshot",
+		arguments: "SNAPSHOT",
+		options:   func() interface{} { return &AwsRdsDeleteSnapshotOptions{} },
+		run:       (*BasicPlugin).AwsRdsDeleteSnapshotRun,
+	},
+	{
+		name:      "aws-rds-restore",
+		helpText:  "command to restore an RDS instance from a DB snapshot or point in time and register it as a new service with CF",
+		arguments: "SERVICE_NAME",
+		exclusive: []string{"from-snapshot", "from-service"},
+		options:   func() interface{} { return &AwsRdsRestoreOptions{} },
+		run:       (*BasicPlugin).AwsRdsRestoreRun,
+	},
+	{
+		name:      "aws-rds-scale",
+		helpText:  "command to change the instance class, storage or provisioned IOPS of the RDS instance registered as a service with CF",
+		arguments: "SERVICE_NAME",
+		options:   func() interface{} { return &AwsRdsScaleOptions{} },
+		run:       (*BasicPlugin).AwsRdsScaleRun,
+	},
+	{
+		name:      "aws-rds-stop",
+		helpText:  "command to stop the RDS instance registered as a service with CF",
+		arguments: "SERVICE_NAME",
+		options:   func() interface{} { return &AwsRdsStopOptions{} },
+		run:       (*BasicPlugin).AwsRdsStopRun,
+	},
+	{
+		name:      "aws-rds-start",
+		helpText:  "command to start the stopped RDS instance registered as a service with CF",
+		arguments: "SERVICE_NAME",
+		options:   func() interface{} { return &AwsRdsStartOptions{} },
+		run:       (*BasicPlugin).AwsRdsStartRun,
+	},
+	{
+		name:      "aws-rds-reboot",
+		helpText:  "command to reboot the RDS instance registered as a service with CF",
+		arguments: "SERVICE_NAME",
+		options:   func() interface{} { return &AwsRdsRebootOptions{} },
+		run:       (*BasicPlugin).AwsRdsRebootRun,
+	},
+	{
+		name:     "aws-rds-subnet-groups",
+		helpText: "command to list the DB subnet groups RDS instances can be created in",
+		options:  func() interface{} { return &AwsRdsSubnetGroupsOptions{} },
+		run:      (*BasicPlugin).AwsRdsSubnetGroupsRun,
+	},
+	{
+		name:      "aws-rds-create-replica",
+		helpText:  "command to create a read replica of the RDS instance registered as a service with CF and register the replica as a service of its own",
+		arguments: "SOURCE_SERVICE REPLICA_SERVICE",
+		options:   func() interface{} { return &AwsRdsCreateReplicaOptions{} },
+		run:       (*BasicPlugin).AwsRdsCreateReplicaRun,
+	},
+	{
+		name:      "aws-rds-promote-replica",
+		helpText:  "command to promote the read replica registered as a service with CF to a standalone RDS instance",
+		arguments: "SERVICE_NAME",
+		options:   func() interface{} { return &AwsRdsPromoteReplicaOptions{} },
+		run:       (*BasicPlugin).AwsRdsPromoteReplicaRun,
+	},
+	{
+		name:      "aws-rds-set-parameters",
+		helpText:  "command to set DB parameters of the RDS instance from a YAML or JSON file",
+		arguments: "SERVICE_NAME FILE",
+		options:   func() interface{} { return &AwsRdsSetParametersOptions{} },
+		run:       (*BasicPlugin).AwsRdsSetParametersRun,
+	},
+	{
+		name:      "aws-rds-token",
+		helpText:  "command to generate a short-lived IAM auth token for the DB user of a service created with --iam-auth",
+		arguments: "SERVICE_NAME",
+		options:   func() interface{} { return &AwsRdsTokenOptions{} },
+		run:       (*BasicPlugin).AwsRdsTokenRun,
+	},
+}
+
+func lookupCommand(name string) (command, bool) {
+	for _, cmd := range commands {
+		if cmd.name == name {
+			return cmd, true
+		}
+	}
+	return command{}, false
+}
+
+// usage returns the usage line of the command, e.g.
+// "cf aws-rds-snapshot SERVICE_NAME [--name NAME]". Flags are optional unless
+// tagged as required or exclusive, which are grouped where the first of them
+// would go.
+func (cmd command) usage() string {
+	usage := []string{"cf", cmd.name}
+	if cmd.arguments != "" {
+		usage = append(usage, cmd.arguments)
+	}
+
+	group := []string{}
+	groupAt := -1
+	for _, option := range flagOptions(cmd.options()) {
+		flag := flagName(option)
+		if option.Field().Type.Kind() != reflect.Bool {
+			flag += " " + valueName(option)
+		}
+		if cmd.isExclusive(option.LongName) {
+			if groupAt < 0 {
+				groupAt = len(usage)
+				usage = append(usage, "")
+			}
+			group = append(group, flag)
+			continue
+		}
+		if !option.Required {
+			flag = "[" + flag + "]"
+		}
+		usage = append(usage, flag)
+	}
+	if groupAt >= 0 {
+		usage[groupAt] = "(" + strings.Join(group, " | ") + ")"
+	}
+
+	return strings.Join(usage, " ")
+}
+
+func (cmd command) isExclusive(longName string) bool {
+	for _, name := range cmd.exclusive {
+		if name == longName {
+			return true
+		}
+	}
+	return false
+}
+
+// flagDocs documents the flags of the command and the global options, keyed
+// as the cf CLI expects: the short name for short flags and the long name
+// preceded by a dash for long ones.
+func (cmd command) flagDocs() map[string]string {
+	docs := map[string]string{}
+	for _, data := range []interface{}{cmd.options(), &GlobalOptions{}} {
+		for _, option := range flagOptions(data) {
+			key := "-" + option.LongName
+			if option.LongName == "" {
+				key = string(option.ShortName)
+			}
+
+			docs[key] = option.Description
+			if len(option.Default) > 0 {
+				docs[key] += fmt.Sprintf(" Defaults to %s.", strings.Join(option.Default, ", "))
+			}
+		}
+	}
+	return docs
+}
+
+func (cmd command) metadata() plugin.Command {
+	return plugin.Command{
+		Name:     cmd.name,
+		HelpText: cmd.helpText,
+
+		UsageDetails: plugin.Usage{
+			Usage:   cmd.usage(),
+			Options: cmd.flagDocs(),
+		},
+	}
+}
+
+// displayUsage shows the usage of the command the way `cf help` does.
+func (c *BasicPlugin) displayUsage(cmd command) {
+	c.UI.DisplayText("NAME:\n   {{.Name}} - {{.HelpText}}\n\nUSAGE:\n   {{.Usage}}\n\nOPTIONS:", map[string]interface{}{
+		"Name":     cmd.name,
+		"HelpText": cmd.helpText,
+		"Usage":    cmd.usage(),
+	})
+
+	docs := cmd.flagDocs()
+	keys := []string{}
+	for key := range docs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	table := [][]string{}
+	for _, key := range keys {
+		table = append(table, []string{"-" + key, docs[key]})
+	}
+	c.UI.DisplayKeyValueTable("   ", table, 3)
+}
+
+// displayCommands lists the commands of the plugin.
+func (c *BasicPlugin) displayCommands() {
+	c.UI.DisplayText("Commands offered by the plugin:")
+
+	table := [][]string{}
+	for _, cmd := range commands {
+		table = append(table, []string{cmd.name, cmd.helpText})
+	}
+	c.UI.DisplayKeyValueTable("   ", table, 3)
+}
+
+// flagOptions returns the options go-flags finds in data, in the order of
+// its fields.
+func flagOptions(data interface{}) []*flags.Option {
+	parser := flags.NewParser(data, flags.None)
+	return groupOptions(parser.Group)
+}
+
+func groupOptions(group *flags.Group) []*flags.Option {
+	options := group.Options()
+	for _, subgroup := range group.Groups() {
+		options = append(options, groupOptions(subgroup)...)
+	}
+	return options
+}
+
+func flagName(option *flags.Option) string {
+	if option.LongName == "" {
+		return "-" + string(option.ShortName)
+	}
+	return "--" + option.LongName
+}
+
+// valueName returns the value-name tag of the option, or its long name in
+// upper case, e.g. CLASS for --class.
+func valueName(option *flags.Option) string {
+	if option.ValueName != "" {
+		return option.ValueName
+	}
+	return strings.ToUpper(strings.Replace(option.LongName, "-", "_", -1))
+}
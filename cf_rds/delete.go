@@ -0,0 +1,154 @@
+package cf_rds
+
+import (
+	"context"
+	"fmt"
+
+	"code.cloudfoundry.org/cli/cf/errors"
+	"code.cloudfoundry.org/cli/plugin"
+	"code.cloudfoundry.org/cli/plugin/models"
+	"github.com/seattle-beach/cf-cli-rds-plugin/api"
+)
+
+type AwsRdsDeleteOptions struct {
+	ServiceName       string
+	SkipFinalSnapshot bool   `long:"skip-final-snapshot" description:"Delete the RDS instance without taking a final DB snapshot. Either this or --final-snapshot must be given." required:"false"`
+	FinalSnapshot     string `long:"final-snapshot" value-name:"NAME" description:"The name of the DB snapshot to take before the RDS instance is deleted." required:"false"`
+	Force             bool   `short:"f" description:"Force deletion without confirmation." required:"false"`
+}
+
+func (a *AwsRdsDeleteOptions) SetServiceName(name string) {
+	a.ServiceName = name
+}
+
+func (c *BasicPlugin) AwsRdsDeleteRun(cliConnection plugin.CliConnection, args []string) error {
+	opts := AwsRdsDeleteOptions{}
+	err := c.getOptions(&opts, args)
+	if err != nil {
+		return err
+	}
+
+	if opts.SkipFinalSnapshot == (opts.FinalSnapshot != "") {
+		err = usageError(errors.New("Incorrect Usage: exactly one of --skip-final-snapshot or --final-snapshot NAME must be given"))
+		c.UI.DisplayError(err)
+		return err
+	}
+
+	if !opts.Force {
+		confirmed, err := c.UI.DisplayBoolPrompt(false, "Really delete the RDS instance and service {{.ServiceName}}?", map[string]interface{}{
+			"ServiceName": opts.ServiceName,
+		})
+		if err != nil {
+			c.UI.DisplayError(err)
+			return err
+		}
+		if !confirmed {
+			c.UI.DisplayText("Delete cancelled")
+			return nil
+		}
+	}
+
+	instanceName, err := c.instanceName(cliConnection, opts.ServiceName)
+	if err != nil {
+		c.UI.DisplayError(err)
+		return err
+	}
+	tags, err := serviceTags(cliConnection, opts.ServiceName)
+	if err != nil {
+		c.UI.DisplayError(err)
+		return err
+	}
+
+	service, err := findService(opts.ServiceName, cliConnection)
+	if err != nil {
+		c.UI.DisplayError(err)
+		return err
+	}
+
+	// The IAM role of the service is only known from its credentials.
+	roleARN := ""
+	if service != nil {
+		credentials, err := c.getUPSCredentials(opts.ServiceName, cliConnection)
+		if err != nil {
+			c.UI.DisplayError(err)
+			return err
+		}
+		roleARN = credentials.RoleARN
+	}
+
+	dbInstance := &api.DBInstance{
+		InstanceName: instanceName,
+	}
+
+	// The service is only deleted once RDS has accepted to delete the
+	// instance, as unbinding apps cannot be undone.
+	err = c.Api.DeleteInstance(dbInstance, opts.FinalSnapshot)
+	if err != nil {
+		c.UI.DisplayError(err)
+		return err
+	}
+
+	if service != nil {
+		err = c.deleteUPS(service, cliConnection)
+		if err != nil {
+			c.UI.DisplayError(err)
+			return err
+		}
+	}
+
+	c.UI.DisplayText("Deleting RDS Instance. This may take several minutes...")
+	resumeHint := fmt.Sprintf("RDS keeps deleting instance %s in the background.", instanceName)
+	err = c.runOperation(resumeHint, func(ctx context.Context) error {
+		err := c.Api.WaitForDeletion(ctx, dbInstance, opts.FinalSnapshot)
+		if err != nil {
+			return err
+		}
+		err = c.Api.DeleteSecurityGroups(ctx, tags)
+		if err != nil {
+			return err
+		}
+		err = c.Api.DeleteParameterGroup(instanceName)
+		if err != nil || roleARN == "" {
+			return err
+		}
+		return c.Api.RevokeIAMAuth(instanceName, roleARN)
+	})
+	if err != nil {
+		c.UI.DisplayError(err)
+		return err
+	}
+
+	c.UI.DisplayText("Successfully deleted RDS instance and service {{.ServiceName}}", map[string]interface{}{
+		"ServiceName": opts.ServiceName,
+	})
+	return nil
+}
+
+// findService returns the service of the name in the current space, or nil if
+// there is none, so that orphaned RDS instances can still be deleted.
+func findService(serviceName string, cli plugin.CliConnection) (*plugin_models.GetServices_Model, error) {
+	services, err := cli.GetServices()
+	if err != nil {
+		return nil, cfCLIError(err)
+	}
+
+	for _, service := range services {
+		if service.Name == serviceName {
+			return &service, nil
+		}
+	}
+	return nil, nil
+}
+
+// deleteUPS unbinds the user-provided service from every app and deletes it.
+func (c *BasicPlugin) deleteUPS(service *plugin_models.GetServices_Model, cli plugin.CliConnection) error {
+	for _, appName := range service.ApplicationNames {
+		_, err := cli.CliCommand("unbind-service", appName, service.Name)
+		if err != nil {
+			return cfCLIError(err)
+		}
+	}
+
+	_, err := cli.CliCommand("delete-service", service.Name, "-f")
+	return cfCLIError(err)
+}
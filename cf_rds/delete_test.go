@@ -0,0 +1,218 @@
+package cf_rds_test
+
+import (
+	"context"
+	"errors"
+
+	"code.cloudfoundry.org/cli/plugin/models"
+	"code.cloudfoundry.org/cli/plugin/pluginfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/seattle-beach/cf-cli-rds-plugin/cf_rds"
+	"github.com/seattle-beach/cf-cli-rds-plugin/cf_rds/fakes"
+)
+
+var _ = Describe("delete", func() {
+	var ui MockUi
+	var conn *pluginfakes.FakeCliConnection
+	var fakeApi *fakes.FakeApi
+	var p *BasicPlugin
+	var args []string
+
+	BeforeEach(func() {
+		conn = &pluginfakes.FakeCliConnection{}
+		ui = MockUi{}
+		fakeApi = &fakes.FakeApi{}
+
+		p = &BasicPlugin{
+			UI:  &ui,
+			Api: fakeApi,
+		}
+		args = []string{"aws-rds-delete", "name", "--skip-final-snapshot", "-f"}
+
+		conn.GetServicesReturns([]plugin_models.GetServices_Model{
+			{Name: "other-service", ApplicationNames: []string{"other-app"}},
+			{Name: "name", ApplicationNames: []string{"app1", "app2"}},
+		}, nil)
+		conn.GetServiceReturns(plugin_models.GetService_Model{
+			Guid:           "service-guid",
+			Name:           "name",
+			IsUserProvided: true,
+		}, nil)
+		conn.CliCommandWithoutTerminalOutputReturns([]string{`{"entity": {"credentials": {"password": "password"}}}`}, nil)
+		conn.GetCurrentSpaceReturns(plugin_models.Space{
+			SpaceFields: plugin_models.SpaceFields{Guid: "space-guid"},
+		}, nil)
+	})
+
+	It("unbinds the service from all apps and deletes it", func() {
+		p.Run(conn, args)
+		Expect(conn.CliCommandCallCount()).To(Equal(3))
+		Expect(conn.CliCommandArgsForCall(0)).To(Equal([]string{"unbind-service", "app1", "name"}))
+		Expect(conn.CliCommandArgsForCall(1)).To(Equal([]string{"unbind-service", "app2", "name"}))
+		Expect(conn.CliCommandArgsForCall(2)).To(Equal([]string{"delete-service", "name", "-f"}))
+	})
+
+	It("deletes the RDS instance without a final snapshot", func() {
+		p.Run(conn, args)
+		Expect(fakeApi.DeleteInstanceCallCount()).To(Equal(1))
+		instance, finalSnapshotName := fakeApi.DeleteInstanceArgsForCall(0)
+		Expect(instance.InstanceName).To(Equal("name"))
+		Expect(finalSnapshotName).To(Equal(""))
+	})
+
+	It("waits for the RDS instance to be gone", func() {
+		p.Run(conn, args)
+		Expect(fakeApi.WaitForDeletionCallCount()).To(Equal(1))
+		_, instance, _ := fakeApi.WaitForDeletionArgsForCall(0)
+		Expect(instance.InstanceName).To(Equal("name"))
+	})
+
+	It("deletes the security groups created for the RDS instance in this space once it is gone", func() {
+		p.Run(conn, args)
+		Expect(fakeApi.DeleteSecurityGroupsCallCount()).To(Equal(1))
+		_, tags := fakeApi.DeleteSecurityGroupsArgsForCall(0)
+		Expect(tags).To(Equal(map[string]string{
+			"cf-rds-service":    "name",
+			"cf-rds-space-guid": "space-guid",
+		}))
+	})
+
+	It("deletes the DB parameter group of the RDS instance", func() {
+		p.Run(conn, args)
+		Expect(fakeApi.DeleteParameterGroupCallCount()).To(Equal(1))
+		Expect(fakeApi.DeleteParameterGroupArgsForCall(0)).To(Equal("name"))
+	})
+
+	It("displays a success message", func() {
+		err := p.AwsRdsDeleteRun(conn, args)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ui.TextTemplate).To(Equal("Successfully deleted RDS instance and service {{.ServiceName}}"))
+	})
+
+	Context("when a final snapshot is requested", func() {
+		BeforeEach(func() {
+			args = []string{"aws-rds-delete", "name", "--final-snapshot", "last-one", "-f"}
+		})
+
+		It("passes the snapshot name to the API", func() {
+			p.Run(conn, args)
+			_, finalSnapshotName := fakeApi.DeleteInstanceArgsForCall(0)
+			Expect(finalSnapshotName).To(Equal("last-one"))
+			_, _, finalSnapshotName = fakeApi.WaitForDeletionArgsForCall(0)
+			Expect(finalSnapshotName).To(Equal("last-one"))
+		})
+	})
+
+	Context("when the service does not exist in CF", func() {
+		BeforeEach(func() {
+			conn.GetServicesReturns([]plugin_models.GetServices_Model{}, nil)
+		})
+
+		It("still deletes the RDS instance", func() {
+			p.Run(conn, args)
+			Expect(conn.CliCommandCallCount()).To(Equal(0))
+			Expect(conn.GetServiceCallCount()).To(Equal(0))
+			Expect(fakeApi.DeleteInstanceCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("confirmation", func() {
+		BeforeEach(func() {
+			args = []string{"aws-rds-delete", "name", "--skip-final-snapshot"}
+		})
+
+		It("asks for confirmation without -f", func() {
+			ui.PromptResponse = true
+			p.Run(conn, args)
+			Expect(ui.PromptTemplate).To(Equal("Really delete the RDS instance and service {{.ServiceName}}?"))
+			Expect(fakeApi.DeleteInstanceCallCount()).To(Equal(1))
+		})
+
+		It("asks for -f instead of prompting with --output json", func() {
+			exitCode := 0
+			p.Exit = func(code int) {
+				exitCode = code
+			}
+			p.Run(conn, append(args, "--output", "json"))
+			Expect(ui.PromptTemplate).To(BeEmpty())
+			Expect(ui.Out.String()).To(MatchJSON(`{"schema_version": 1, "error": {"message": "Incorrect Usage: cannot ask for confirmation with --output json or yaml; pass -f to go ahead"}}`))
+			Expect(exitCode).To(Equal(ExitUsage))
+			Expect(fakeApi.DeleteInstanceCallCount()).To(Equal(0))
+		})
+
+		It("does nothing when the user declines", func() {
+			ui.PromptResponse = false
+			err := p.AwsRdsDeleteRun(conn, args)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ui.TextTemplate).To(Equal("Delete cancelled"))
+			Expect(conn.CliCommandCallCount()).To(Equal(0))
+			Expect(fakeApi.DeleteInstanceCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("error cases", func() {
+		It("displays usage if there are no arguments", func() {
+			args = []string{"aws-rds-delete"}
+			p.Run(conn, args)
+			Expect(ui.Data["Usage"]).To(HavePrefix("cf aws-rds-delete"))
+		})
+
+		It("requires a final snapshot choice", func() {
+			args = []string{"aws-rds-delete", "name", "-f"}
+			err := p.AwsRdsDeleteRun(conn, args)
+			Expect(err).To(MatchError("Incorrect Usage: exactly one of --skip-final-snapshot or --final-snapshot NAME must be given"))
+			Expect(fakeApi.DeleteInstanceCallCount()).To(Equal(0))
+		})
+
+		It("rejects both snapshot options together", func() {
+			args = []string{"aws-rds-delete", "name", "--skip-final-snapshot", "--final-snapshot", "snap", "-f"}
+			err := p.AwsRdsDeleteRun(conn, args)
+			Expect(err).To(HaveOccurred())
+			Expect(fakeApi.DeleteInstanceCallCount()).To(Equal(0))
+		})
+
+		It("displays the error if deleting the instance fails", func() {
+			fakeApi.DeleteInstanceReturns(errors.New("boom"))
+			err := p.AwsRdsDeleteRun(conn, args)
+			Expect(err).To(MatchError("boom"))
+			Expect(ui.Err).To(MatchError("boom"))
+		})
+
+		It("keeps the service if RDS does not accept to delete the instance", func() {
+			fakeApi.DeleteInstanceReturns(errors.New("InvalidDBInstanceState"))
+			p.Run(conn, args)
+			Expect(ui.Err).To(MatchError("InvalidDBInstanceState"))
+			Expect(conn.CliCommandCallCount()).To(Equal(0))
+			Expect(fakeApi.WaitForDeletionCallCount()).To(Equal(0))
+		})
+
+		It("deletes nothing if the credentials of the service cannot be read", func() {
+			conn.CliCommandWithoutTerminalOutputReturns(nil, errors.New("curl failed"))
+			err := p.AwsRdsDeleteRun(conn, args)
+			Expect(err).To(MatchError("curl failed"))
+			Expect(fakeApi.DeleteInstanceCallCount()).To(Equal(0))
+			Expect(conn.CliCommandCallCount()).To(Equal(0))
+		})
+
+		It("leaves the security groups alone if deleting the instance fails", func() {
+			fakeApi.WaitForDeletionReturns(errors.New("boom"))
+			p.Run(conn, args)
+			Expect(fakeApi.DeleteSecurityGroupsCallCount()).To(Equal(0))
+		})
+
+		It("displays the error if deleting the security groups fails", func() {
+			fakeApi.DeleteSecurityGroupsReturns(errors.New("DependencyViolation"))
+			err := p.AwsRdsDeleteRun(conn, args)
+			Expect(err).To(MatchError("DependencyViolation"))
+			Expect(ui.Err).To(MatchError("DependencyViolation"))
+		})
+
+		It("tells the user RDS carries on when interrupted", func() {
+			fakeApi.WaitForDeletionReturns(context.Canceled)
+			err := p.AwsRdsDeleteRun(conn, args)
+			Expect(err).To(MatchError("Interrupted. RDS keeps deleting instance name in the background."))
+			Expect(ui.Err).To(Equal(err))
+		})
+	})
+})
@@ -0,0 +1,66 @@
+package cf_rds
+
+import (
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/seattle-beach/cf-cli-rds-plugin/api"
+)
+
+// Exit codes of the plugin process, one for each kind of failure.
+const (
+	// ExitFailure is used for failures of no particular kind.
+	ExitFailure = 1
+	// ExitUsage means the command line was invalid.
+	ExitUsage = 2
+	// ExitCredentials means no valid AWS credentials were found.
+	ExitCredentials = 3
+	// ExitAWS means an AWS API request failed.
+	ExitAWS = 4
+	// ExitTimeout means an operation did not finish within --timeout.
+	ExitTimeout = 5
+	// ExitCFCLI means a cf CLI command or CLI RPC call failed.
+	ExitCFCLI = 6
+	// ExitInterrupted means the user interrupted an operation with Ctrl-C.
+	ExitInterrupted = 130
+)
+
+// CommandError is an error of a known kind. Its message is the message of the
+// error it wraps.
+type CommandError struct {
+	ExitCode int
+	Err      error
+}
+
+func (e *CommandError) Error() string {
+	return e.Err.Error()
+}
+
+func usageError(err error) error {
+	return &CommandError{ExitCode: ExitUsage, Err: err}
+}
+
+// cfCLIError marks err as returned by the cf CLI. It returns nil if err is
+// nil, so that it can wrap the result of a CLI call directly.
+func cfCLIError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &CommandError{ExitCode: ExitCFCLI, Err: err}
+}
+
+// ExitCode returns the code the plugin exits with after a command failed with
+// err.
+func ExitCode(err error) int {
+	switch e := err.(type) {
+	case *CommandError:
+		return e.ExitCode
+	case awserr.Error:
+		if e.Code() == "NoCredentialProviders" {
+			return ExitCredentials
+		}
+		return ExitAWS
+	}
+	if err == api.ErrNoCredentials {
+		return ExitCredentials
+	}
+	return ExitFailure
+}
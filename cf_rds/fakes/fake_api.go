@@ -2,61 +2,411 @@
 package fakes
 
 import (
+	"context"
 	"sync"
+	"time"
 
-	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/seattle-beach/cf-cli-rds-plugin/api"
 	"github.com/seattle-beach/cf-cli-rds-plugin/cf_rds"
 )
 
 type FakeApi struct {
-	GetSubnetGroupsStub        func() ([]*rds.DBSubnetGroup, error)
+	GetSubnetGroupsStub        func(filter api.SubnetGroupFilter) ([]*api.SubnetGroup, error)
 	getSubnetGroupsMutex       sync.RWMutex
-	getSubnetGroupsArgsForCall []struct{}
-	getSubnetGroupsReturns     struct {
-		result1 []*rds.DBSubnetGroup
+	getSubnetGroupsArgsForCall []struct {
+		filter api.SubnetGroupFilter
+	}
+	getSubnetGroupsReturns struct {
+		result1 []*api.SubnetGroup
 		result2 error
 	}
 	getSubnetGroupsReturnsOnCall map[int]struct {
-		result1 []*rds.DBSubnetGroup
+		result1 []*api.SubnetGroup
 		result2 error
 	}
-	CreateInstanceStub        func(instance *api.DBInstance) (chan error, error)
+	CreateInstanceStub        func(ctx context.Context, instance *api.DBInstance) error
 	createInstanceMutex       sync.RWMutex
 	createInstanceArgsForCall []struct {
+		ctx      context.Context
 		instance *api.DBInstance
 	}
 	createInstanceReturns struct {
-		result1 chan error
-		result2 error
+		result1 error
 	}
 	createInstanceReturnsOnCall map[int]struct {
-		result1 chan error
-		result2 error
+		result1 error
 	}
-	RefreshInstanceStub        func(instance *api.DBInstance) chan error
+	RefreshInstanceStub        func(ctx context.Context, instance *api.DBInstance) error
 	refreshInstanceMutex       sync.RWMutex
 	refreshInstanceArgsForCall []struct {
+		ctx      context.Context
 		instance *api.DBInstance
 	}
 	refreshInstanceReturns struct {
-		result1 chan error
+		result1 error
 	}
 	refreshInstanceReturnsOnCall map[int]struct {
-		result1 chan error
+		result1 error
+	}
+	DeleteInstanceStub        func(instance *api.DBInstance, finalSnapshotName string) error
+	deleteInstanceMutex       sync.RWMutex
+	deleteInstanceArgsForCall []struct {
+		instance          *api.DBInstance
+		finalSnapshotName string
+	}
+	deleteInstanceReturns struct {
+		result1 error
+	}
+	deleteInstanceReturnsOnCall map[int]struct {
+		result1 error
+	}
+	WaitForDeletionStub        func(ctx context.Context, instance *api.DBInstance, finalSnapshotName string) error
+	waitForDeletionMutex       sync.RWMutex
+	waitForDeletionArgsForCall []struct {
+		ctx               context.Context
+		instance          *api.DBInstance
+		finalSnapshotName string
+	}
+	waitForDeletionReturns struct {
+		result1 error
+	}
+	waitForDeletionReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ListInstancesStub        func() ([]*api.DBInstance, error)
+	listInstancesMutex       sync.RWMutex
+	listInstancesArgsForCall []struct{}
+	listInstancesReturns     struct {
+		result1 []*api.DBInstance
+		result2 error
+	}
+	listInstancesReturnsOnCall map[int]struct {
+		result1 []*api.DBInstance
+		result2 error
+	}
+	DescribeInstanceStub        func(instanceName string) (*api.DBInstance, error)
+	describeInstanceMutex       sync.RWMutex
+	describeInstanceArgsForCall []struct {
+		instanceName string
+	}
+	describeInstanceReturns struct {
+		result1 *api.DBInstance
+		result2 error
+	}
+	describeInstanceReturnsOnCall map[int]struct {
+		result1 *api.DBInstance
+		result2 error
+	}
+	ResetPasswordStub        func(ctx context.Context, instance *api.DBInstance, password string) error
+	resetPasswordMutex       sync.RWMutex
+	resetPasswordArgsForCall []struct {
+		ctx      context.Context
+		instance *api.DBInstance
+		password string
+	}
+	resetPasswordReturns struct {
+		result1 error
+	}
+	resetPasswordReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CreateSnapshotStub        func(ctx context.Context, instanceName string, snapshotName string) error
+	createSnapshotMutex       sync.RWMutex
+	createSnapshotArgsForCall []struct {
+		ctx          context.Context
+		instanceName string
+		snapshotName string
+	}
+	createSnapshotReturns struct {
+		result1 error
+	}
+	createSnapshotReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ListSnapshotsStub        func(instanceName string) ([]*api.DBSnapshot, error)
+	listSnapshotsMutex       sync.RWMutex
+	listSnapshotsArgsForCall []struct {
+		instanceName string
+	}
+	listSnapshotsReturns struct {
+		result1 []*api.DBSnapshot
+		result2 error
+	}
+	listSnapshotsReturnsOnCall map[int]struct {
+		result1 []*api.DBSnapshot
+		result2 error
+	}
+	DescribeSnapshotStub        func(snapshotName string) (*api.DBSnapshot, *api.DBInstance, error)
+	describeSnapshotMutex       sync.RWMutex
+	describeSnapshotArgsForCall []struct {
+		snapshotName string
+	}
+	describeSnapshotReturns struct {
+		result1 *api.DBSnapshot
+		result2 *api.DBInstance
+		result3 error
+	}
+	describeSnapshotReturnsOnCall map[int]struct {
+		result1 *api.DBSnapshot
+		result2 *api.DBInstance
+		result3 error
+	}
+	DeleteSnapshotStub        func(snapshotName string) error
+	deleteSnapshotMutex       sync.RWMutex
+	deleteSnapshotArgsForCall []struct {
+		snapshotName string
+	}
+	deleteSnapshotReturns struct {
+		result1 error
+	}
+	deleteSnapshotReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RestoreFromSnapshotStub        func(ctx context.Context, instance *api.DBInstance, snapshotName string) error
+	restoreFromSnapshotMutex       sync.RWMutex
+	restoreFromSnapshotArgsForCall []struct {
+		ctx          context.Context
+		instance     *api.DBInstance
+		snapshotName string
+	}
+	restoreFromSnapshotReturns struct {
+		result1 error
+	}
+	restoreFromSnapshotReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RestoreToPointInTimeStub        func(ctx context.Context, instance *api.DBInstance, sourceInstanceName string, restoreTime time.Time) error
+	restoreToPointInTimeMutex       sync.RWMutex
+	restoreToPointInTimeArgsForCall []struct {
+		ctx                context.Context
+		instance           *api.DBInstance
+		sourceInstanceName string
+		restoreTime        time.Time
+	}
+	restoreToPointInTimeReturns struct {
+		result1 error
+	}
+	restoreToPointInTimeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ScaleInstanceStub        func(instance *api.DBInstance, applyImmediately bool) error
+	scaleInstanceMutex       sync.RWMutex
+	scaleInstanceArgsForCall []struct {
+		instance         *api.DBInstance
+		applyImmediately bool
+	}
+	scaleInstanceReturns struct {
+		result1 error
+	}
+	scaleInstanceReturnsOnCall map[int]struct {
+		result1 error
+	}
+	WaitForScaleStub        func(ctx context.Context, instance *api.DBInstance, applyImmediately bool) error
+	waitForScaleMutex       sync.RWMutex
+	waitForScaleArgsForCall []struct {
+		ctx              context.Context
+		instance         *api.DBInstance
+		applyImmediately bool
+	}
+	waitForScaleReturns struct {
+		result1 error
+	}
+	waitForScaleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	StopInstanceStub        func(ctx context.Context, instanceName string) error
+	stopInstanceMutex       sync.RWMutex
+	stopInstanceArgsForCall []struct {
+		ctx          context.Context
+		instanceName string
+	}
+	stopInstanceReturns struct {
+		result1 error
+	}
+	stopInstanceReturnsOnCall map[int]struct {
+		result1 error
+	}
+	StartInstanceStub        func(ctx context.Context, instanceName string) error
+	startInstanceMutex       sync.RWMutex
+	startInstanceArgsForCall []struct {
+		ctx          context.Context
+		instanceName string
+	}
+	startInstanceReturns struct {
+		result1 error
+	}
+	startInstanceReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RebootInstanceStub        func(ctx context.Context, instanceName string, forceFailover bool) error
+	rebootInstanceMutex       sync.RWMutex
+	rebootInstanceArgsForCall []struct {
+		ctx           context.Context
+		instanceName  string
+		forceFailover bool
+	}
+	rebootInstanceReturns struct {
+		result1 error
+	}
+	rebootInstanceReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CreateSecurityGroupStub        func(serviceName string, vpc string, port int64, cidrs []string, tags map[string]string) (string, error)
+	createSecurityGroupMutex       sync.RWMutex
+	createSecurityGroupArgsForCall []struct {
+		serviceName string
+		vpc         string
+		port        int64
+		cidrs       []string
+		tags        map[string]string
+	}
+	createSecurityGroupReturns struct {
+		result1 string
+		result2 error
+	}
+	createSecurityGroupReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	DeleteSecurityGroupStub        func(groupID string) error
+	deleteSecurityGroupMutex       sync.RWMutex
+	deleteSecurityGroupArgsForCall []struct {
+		groupID string
+	}
+	deleteSecurityGroupReturns struct {
+		result1 error
+	}
+	deleteSecurityGroupReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteSecurityGroupsStub        func(ctx context.Context, tags map[string]string) error
+	deleteSecurityGroupsMutex       sync.RWMutex
+	deleteSecurityGroupsArgsForCall []struct {
+		ctx  context.Context
+		tags map[string]string
+	}
+	deleteSecurityGroupsReturns struct {
+		result1 error
+	}
+	deleteSecurityGroupsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CreateReplicaStub        func(ctx context.Context, replica *api.DBInstance, source string) error
+	createReplicaMutex       sync.RWMutex
+	createReplicaArgsForCall []struct {
+		ctx     context.Context
+		replica *api.DBInstance
+		source  string
+	}
+	createReplicaReturns struct {
+		result1 error
+	}
+	createReplicaReturnsOnCall map[int]struct {
+		result1 error
+	}
+	PromoteReplicaStub        func(ctx context.Context, instance *api.DBInstance) error
+	promoteReplicaMutex       sync.RWMutex
+	promoteReplicaArgsForCall []struct {
+		ctx      context.Context
+		instance *api.DBInstance
+	}
+	promoteReplicaReturns struct {
+		result1 error
+	}
+	promoteReplicaReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SetParametersStub        func(instanceName string, parameters map[string]string) ([]string, error)
+	setParametersMutex       sync.RWMutex
+	setParametersArgsForCall []struct {
+		instanceName string
+		parameters   map[string]string
+	}
+	setParametersReturns struct {
+		result1 []string
+		result2 error
+	}
+	setParametersReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	DeleteParameterGroupStub        func(serviceName string) error
+	deleteParameterGroupMutex       sync.RWMutex
+	deleteParameterGroupArgsForCall []struct {
+		serviceName string
+	}
+	deleteParameterGroupReturns struct {
+		result1 error
+	}
+	deleteParameterGroupReturnsOnCall map[int]struct {
+		result1 error
+	}
+	FindInstanceStub        func(instanceName string, tags map[string]string) (*api.DBInstance, error)
+	findInstanceMutex       sync.RWMutex
+	findInstanceArgsForCall []struct {
+		instanceName string
+		tags         map[string]string
+	}
+	findInstanceReturns struct {
+		result1 *api.DBInstance
+		result2 error
+	}
+	findInstanceReturnsOnCall map[int]struct {
+		result1 *api.DBInstance
+		result2 error
+	}
+	SetUpIAMAuthStub        func(ctx context.Context, instance *api.DBInstance, roleARN string) error
+	setUpIAMAuthMutex       sync.RWMutex
+	setUpIAMAuthArgsForCall []struct {
+		ctx      context.Context
+		instance *api.DBInstance
+		roleARN  string
+	}
+	setUpIAMAuthReturns struct {
+		result1 error
+	}
+	setUpIAMAuthReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RevokeIAMAuthStub        func(instanceName string, roleARN string) error
+	revokeIAMAuthMutex       sync.RWMutex
+	revokeIAMAuthArgsForCall []struct {
+		instanceName string
+		roleARN      string
+	}
+	revokeIAMAuthReturns struct {
+		result1 error
+	}
+	revokeIAMAuthReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AuthTokenStub        func(credentials *api.DBInstance) (string, error)
+	authTokenMutex       sync.RWMutex
+	authTokenArgsForCall []struct {
+		credentials *api.DBInstance
+	}
+	authTokenReturns struct {
+		result1 string
+		result2 error
+	}
+	authTokenReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
 	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeApi) GetSubnetGroups() ([]*rds.DBSubnetGroup, error) {
+func (fake *FakeApi) GetSubnetGroups(filter api.SubnetGroupFilter) ([]*api.SubnetGroup, error) {
 	fake.getSubnetGroupsMutex.Lock()
 	ret, specificReturn := fake.getSubnetGroupsReturnsOnCall[len(fake.getSubnetGroupsArgsForCall)]
-	fake.getSubnetGroupsArgsForCall = append(fake.getSubnetGroupsArgsForCall, struct{}{})
-	fake.recordInvocation("GetSubnetGroups", []interface{}{})
+	fake.getSubnetGroupsArgsForCall = append(fake.getSubnetGroupsArgsForCall, struct {
+		filter api.SubnetGroupFilter
+	}{filter})
+	fake.recordInvocation("GetSubnetGroups", []interface{}{filter})
 	fake.getSubnetGroupsMutex.Unlock()
 	if fake.GetSubnetGroupsStub != nil {
-		return fake.GetSubnetGroupsStub()
+		return fake.GetSubnetGroupsStub(filter)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -70,43 +420,50 @@ func (fake *FakeApi) GetSubnetGroupsCallCount() int {
 	return len(fake.getSubnetGroupsArgsForCall)
 }
 
-func (fake *FakeApi) GetSubnetGroupsReturns(result1 []*rds.DBSubnetGroup, result2 error) {
+func (fake *FakeApi) GetSubnetGroupsArgsForCall(i int) api.SubnetGroupFilter {
+	fake.getSubnetGroupsMutex.RLock()
+	defer fake.getSubnetGroupsMutex.RUnlock()
+	return fake.getSubnetGroupsArgsForCall[i].filter
+}
+
+func (fake *FakeApi) GetSubnetGroupsReturns(result1 []*api.SubnetGroup, result2 error) {
 	fake.GetSubnetGroupsStub = nil
 	fake.getSubnetGroupsReturns = struct {
-		result1 []*rds.DBSubnetGroup
+		result1 []*api.SubnetGroup
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeApi) GetSubnetGroupsReturnsOnCall(i int, result1 []*rds.DBSubnetGroup, result2 error) {
+func (fake *FakeApi) GetSubnetGroupsReturnsOnCall(i int, result1 []*api.SubnetGroup, result2 error) {
 	fake.GetSubnetGroupsStub = nil
 	if fake.getSubnetGroupsReturnsOnCall == nil {
 		fake.getSubnetGroupsReturnsOnCall = make(map[int]struct {
-			result1 []*rds.DBSubnetGroup
+			result1 []*api.SubnetGroup
 			result2 error
 		})
 	}
 	fake.getSubnetGroupsReturnsOnCall[i] = struct {
-		result1 []*rds.DBSubnetGroup
+		result1 []*api.SubnetGroup
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeApi) CreateInstance(instance *api.DBInstance) (chan error, error) {
+func (fake *FakeApi) CreateInstance(ctx context.Context, instance *api.DBInstance) error {
 	fake.createInstanceMutex.Lock()
 	ret, specificReturn := fake.createInstanceReturnsOnCall[len(fake.createInstanceArgsForCall)]
 	fake.createInstanceArgsForCall = append(fake.createInstanceArgsForCall, struct {
+		ctx      context.Context
 		instance *api.DBInstance
-	}{instance})
-	fake.recordInvocation("CreateInstance", []interface{}{instance})
+	}{ctx, instance})
+	fake.recordInvocation("CreateInstance", []interface{}{ctx, instance})
 	fake.createInstanceMutex.Unlock()
 	if fake.CreateInstanceStub != nil {
-		return fake.CreateInstanceStub(instance)
+		return fake.CreateInstanceStub(ctx, instance)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1
 	}
-	return fake.createInstanceReturns.result1, fake.createInstanceReturns.result2
+	return fake.createInstanceReturns.result1
 }
 
 func (fake *FakeApi) CreateInstanceCallCount() int {
@@ -115,44 +472,42 @@ func (fake *FakeApi) CreateInstanceCallCount() int {
 	return len(fake.createInstanceArgsForCall)
 }
 
-func (fake *FakeApi) CreateInstanceArgsForCall(i int) *api.DBInstance {
+func (fake *FakeApi) CreateInstanceArgsForCall(i int) (context.Context, *api.DBInstance) {
 	fake.createInstanceMutex.RLock()
 	defer fake.createInstanceMutex.RUnlock()
-	return fake.createInstanceArgsForCall[i].instance
+	return fake.createInstanceArgsForCall[i].ctx, fake.createInstanceArgsForCall[i].instance
 }
 
-func (fake *FakeApi) CreateInstanceReturns(result1 chan error, result2 error) {
+func (fake *FakeApi) CreateInstanceReturns(result1 error) {
 	fake.CreateInstanceStub = nil
 	fake.createInstanceReturns = struct {
-		result1 chan error
-		result2 error
-	}{result1, result2}
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeApi) CreateInstanceReturnsOnCall(i int, result1 chan error, result2 error) {
+func (fake *FakeApi) CreateInstanceReturnsOnCall(i int, result1 error) {
 	fake.CreateInstanceStub = nil
 	if fake.createInstanceReturnsOnCall == nil {
 		fake.createInstanceReturnsOnCall = make(map[int]struct {
-			result1 chan error
-			result2 error
+			result1 error
 		})
 	}
 	fake.createInstanceReturnsOnCall[i] = struct {
-		result1 chan error
-		result2 error
-	}{result1, result2}
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeApi) RefreshInstance(instance *api.DBInstance) chan error {
+func (fake *FakeApi) RefreshInstance(ctx context.Context, instance *api.DBInstance) error {
 	fake.refreshInstanceMutex.Lock()
 	ret, specificReturn := fake.refreshInstanceReturnsOnCall[len(fake.refreshInstanceArgsForCall)]
 	fake.refreshInstanceArgsForCall = append(fake.refreshInstanceArgsForCall, struct {
+		ctx      context.Context
 		instance *api.DBInstance
-	}{instance})
-	fake.recordInvocation("RefreshInstance", []interface{}{instance})
+	}{ctx, instance})
+	fake.recordInvocation("RefreshInstance", []interface{}{ctx, instance})
 	fake.refreshInstanceMutex.Unlock()
 	if fake.RefreshInstanceStub != nil {
-		return fake.RefreshInstanceStub(instance)
+		return fake.RefreshInstanceStub(ctx, instance)
 	}
 	if specificReturn {
 		return ret.result1
@@ -166,31 +521,1378 @@ func (fake *FakeApi) RefreshInstanceCallCount() int {
 	return len(fake.refreshInstanceArgsForCall)
 }
 
-func (fake *FakeApi) RefreshInstanceArgsForCall(i int) *api.DBInstance {
+func (fake *FakeApi) RefreshInstanceArgsForCall(i int) (context.Context, *api.DBInstance) {
 	fake.refreshInstanceMutex.RLock()
 	defer fake.refreshInstanceMutex.RUnlock()
-	return fake.refreshInstanceArgsForCall[i].instance
+	return fake.refreshInstanceArgsForCall[i].ctx, fake.refreshInstanceArgsForCall[i].instance
 }
 
-func (fake *FakeApi) RefreshInstanceReturns(result1 chan error) {
+func (fake *FakeApi) RefreshInstanceReturns(result1 error) {
 	fake.RefreshInstanceStub = nil
 	fake.refreshInstanceReturns = struct {
-		result1 chan error
+		result1 error
 	}{result1}
 }
 
-func (fake *FakeApi) RefreshInstanceReturnsOnCall(i int, result1 chan error) {
+func (fake *FakeApi) RefreshInstanceReturnsOnCall(i int, result1 error) {
 	fake.RefreshInstanceStub = nil
 	if fake.refreshInstanceReturnsOnCall == nil {
 		fake.refreshInstanceReturnsOnCall = make(map[int]struct {
-			result1 chan error
+			result1 error
 		})
 	}
 	fake.refreshInstanceReturnsOnCall[i] = struct {
-		result1 chan error
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeApi) DeleteInstance(instance *api.DBInstance, finalSnapshotName string) error {
+	fake.deleteInstanceMutex.Lock()
+	ret, specificReturn := fake.deleteInstanceReturnsOnCall[len(fake.deleteInstanceArgsForCall)]
+	fake.deleteInstanceArgsForCall = append(fake.deleteInstanceArgsForCall, struct {
+		instance          *api.DBInstance
+		finalSnapshotName string
+	}{instance, finalSnapshotName})
+	fake.recordInvocation("DeleteInstance", []interface{}{instance, finalSnapshotName})
+	fake.deleteInstanceMutex.Unlock()
+	if fake.DeleteInstanceStub != nil {
+		return fake.DeleteInstanceStub(instance, finalSnapshotName)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.deleteInstanceReturns.result1
+}
+
+func (fake *FakeApi) DeleteInstanceCallCount() int {
+	fake.deleteInstanceMutex.RLock()
+	defer fake.deleteInstanceMutex.RUnlock()
+	return len(fake.deleteInstanceArgsForCall)
+}
+
+func (fake *FakeApi) DeleteInstanceArgsForCall(i int) (*api.DBInstance, string) {
+	fake.deleteInstanceMutex.RLock()
+	defer fake.deleteInstanceMutex.RUnlock()
+	return fake.deleteInstanceArgsForCall[i].instance, fake.deleteInstanceArgsForCall[i].finalSnapshotName
+}
+
+func (fake *FakeApi) DeleteInstanceReturns(result1 error) {
+	fake.DeleteInstanceStub = nil
+	fake.deleteInstanceReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeApi) DeleteInstanceReturnsOnCall(i int, result1 error) {
+	fake.DeleteInstanceStub = nil
+	if fake.deleteInstanceReturnsOnCall == nil {
+		fake.deleteInstanceReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteInstanceReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeApi) WaitForDeletion(ctx context.Context, instance *api.DBInstance, finalSnapshotName string) error {
+	fake.waitForDeletionMutex.Lock()
+	ret, specificReturn := fake.waitForDeletionReturnsOnCall[len(fake.waitForDeletionArgsForCall)]
+	fake.waitForDeletionArgsForCall = append(fake.waitForDeletionArgsForCall, struct {
+		ctx               context.Context
+		instance          *api.DBInstance
+		finalSnapshotName string
+	}{ctx, instance, finalSnapshotName})
+	fake.recordInvocation("WaitForDeletion", []interface{}{ctx, instance, finalSnapshotName})
+	fake.waitForDeletionMutex.Unlock()
+	if fake.WaitForDeletionStub != nil {
+		return fake.WaitForDeletionStub(ctx, instance, finalSnapshotName)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.waitForDeletionReturns.result1
+}
+
+func (fake *FakeApi) WaitForDeletionCallCount() int {
+	fake.waitForDeletionMutex.RLock()
+	defer fake.waitForDeletionMutex.RUnlock()
+	return len(fake.waitForDeletionArgsForCall)
+}
+
+func (fake *FakeApi) WaitForDeletionArgsForCall(i int) (context.Context, *api.DBInstance, string) {
+	fake.waitForDeletionMutex.RLock()
+	defer fake.waitForDeletionMutex.RUnlock()
+	return fake.waitForDeletionArgsForCall[i].ctx, fake.waitForDeletionArgsForCall[i].instance, fake.waitForDeletionArgsForCall[i].finalSnapshotName
+}
+
+func (fake *FakeApi) WaitForDeletionReturns(result1 error) {
+	fake.WaitForDeletionStub = nil
+	fake.waitForDeletionReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeApi) WaitForDeletionReturnsOnCall(i int, result1 error) {
+	fake.WaitForDeletionStub = nil
+	if fake.waitForDeletionReturnsOnCall == nil {
+		fake.waitForDeletionReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.waitForDeletionReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeApi) ListInstances() ([]*api.DBInstance, error) {
+	fake.listInstancesMutex.Lock()
+	ret, specificReturn := fake.listInstancesReturnsOnCall[len(fake.listInstancesArgsForCall)]
+	fake.listInstancesArgsForCall = append(fake.listInstancesArgsForCall, struct{}{})
+	fake.recordInvocation("ListInstances", []interface{}{})
+	fake.listInstancesMutex.Unlock()
+	if fake.ListInstancesStub != nil {
+		return fake.ListInstancesStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.listInstancesReturns.result1, fake.listInstancesReturns.result2
+}
+
+func (fake *FakeApi) ListInstancesCallCount() int {
+	fake.listInstancesMutex.RLock()
+	defer fake.listInstancesMutex.RUnlock()
+	return len(fake.listInstancesArgsForCall)
+}
+
+func (fake *FakeApi) ListInstancesReturns(result1 []*api.DBInstance, result2 error) {
+	fake.ListInstancesStub = nil
+	fake.listInstancesReturns = struct {
+		result1 []*api.DBInstance
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApi) ListInstancesReturnsOnCall(i int, result1 []*api.DBInstance, result2 error) {
+	fake.ListInstancesStub = nil
+	if fake.listInstancesReturnsOnCall == nil {
+		fake.listInstancesReturnsOnCall = make(map[int]struct {
+			result1 []*api.DBInstance
+			result2 error
+		})
+	}
+	fake.listInstancesReturnsOnCall[i] = struct {
+		result1 []*api.DBInstance
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApi) DescribeInstance(instanceName string) (*api.DBInstance, error) {
+	fake.describeInstanceMutex.Lock()
+	ret, specificReturn := fake.describeInstanceReturnsOnCall[len(fake.describeInstanceArgsForCall)]
+	fake.describeInstanceArgsForCall = append(fake.describeInstanceArgsForCall, struct {
+		instanceName string
+	}{instanceName})
+	fake.recordInvocation("DescribeInstance", []interface{}{instanceName})
+	fake.describeInstanceMutex.Unlock()
+	if fake.DescribeInstanceStub != nil {
+		return fake.DescribeInstanceStub(instanceName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.describeInstanceReturns.result1, fake.describeInstanceReturns.result2
+}
+
+func (fake *FakeApi) DescribeInstanceCallCount() int {
+	fake.describeInstanceMutex.RLock()
+	defer fake.describeInstanceMutex.RUnlock()
+	return len(fake.describeInstanceArgsForCall)
+}
+
+func (fake *FakeApi) DescribeInstanceArgsForCall(i int) string {
+	fake.describeInstanceMutex.RLock()
+	defer fake.describeInstanceMutex.RUnlock()
+	return fake.describeInstanceArgsForCall[i].instanceName
+}
+
+func (fake *FakeApi) DescribeInstanceReturns(result1 *api.DBInstance, result2 error) {
+	fake.DescribeInstanceStub = nil
+	fake.describeInstanceReturns = struct {
+		result1 *api.DBInstance
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApi) DescribeInstanceReturnsOnCall(i int, result1 *api.DBInstance, result2 error) {
+	fake.DescribeInstanceStub = nil
+	if fake.describeInstanceReturnsOnCall == nil {
+		fake.describeInstanceReturnsOnCall = make(map[int]struct {
+			result1 *api.DBInstance
+			result2 error
+		})
+	}
+	fake.describeInstanceReturnsOnCall[i] = struct {
+		result1 *api.DBInstance
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApi) ResetPassword(ctx context.Context, instance *api.DBInstance, password string) error {
+	fake.resetPasswordMutex.Lock()
+	ret, specificReturn := fake.resetPasswordReturnsOnCall[len(fake.resetPasswordArgsForCall)]
+	fake.resetPasswordArgsForCall = append(fake.resetPasswordArgsForCall, struct {
+		ctx      context.Context
+		instance *api.DBInstance
+		password string
+	}{ctx, instance, password})
+	fake.recordInvocation("ResetPassword", []interface{}{ctx, instance, password})
+	fake.resetPasswordMutex.Unlock()
+	if fake.ResetPasswordStub != nil {
+		return fake.ResetPasswordStub(ctx, instance, password)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.resetPasswordReturns.result1
+}
+
+func (fake *FakeApi) ResetPasswordCallCount() int {
+	fake.resetPasswordMutex.RLock()
+	defer fake.resetPasswordMutex.RUnlock()
+	return len(fake.resetPasswordArgsForCall)
+}
+
+func (fake *FakeApi) ResetPasswordArgsForCall(i int) (context.Context, *api.DBInstance, string) {
+	fake.resetPasswordMutex.RLock()
+	defer fake.resetPasswordMutex.RUnlock()
+	return fake.resetPasswordArgsForCall[i].ctx, fake.resetPasswordArgsForCall[i].instance, fake.resetPasswordArgsForCall[i].password
+}
+
+func (fake *FakeApi) ResetPasswordReturns(result1 error) {
+	fake.ResetPasswordStub = nil
+	fake.resetPasswordReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeApi) ResetPasswordReturnsOnCall(i int, result1 error) {
+	fake.ResetPasswordStub = nil
+	if fake.resetPasswordReturnsOnCall == nil {
+		fake.resetPasswordReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.resetPasswordReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeApi) CreateSnapshot(ctx context.Context, instanceName string, snapshotName string) error {
+	fake.createSnapshotMutex.Lock()
+	ret, specificReturn := fake.createSnapshotReturnsOnCall[len(fake.createSnapshotArgsForCall)]
+	fake.createSnapshotArgsForCall = append(fake.createSnapshotArgsForCall, struct {
+		ctx          context.Context
+		instanceName string
+		snapshotName string
+	}{ctx, instanceName, snapshotName})
+	fake.recordInvocation("CreateSnapshot", []interface{}{ctx, instanceName, snapshotName})
+	fake.createSnapshotMutex.Unlock()
+	if fake.CreateSnapshotStub != nil {
+		return fake.CreateSnapshotStub(ctx, instanceName, snapshotName)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.createSnapshotReturns.result1
+}
+
+func (fake *FakeApi) CreateSnapshotCallCount() int {
+	fake.createSnapshotMutex.RLock()
+	defer fake.createSnapshotMutex.RUnlock()
+	return len(fake.createSnapshotArgsForCall)
+}
+
+func (fake *FakeApi) CreateSnapshotArgsForCall(i int) (context.Context, string, string) {
+	fake.createSnapshotMutex.RLock()
+	defer fake.createSnapshotMutex.RUnlock()
+	return fake.createSnapshotArgsForCall[i].ctx, fake.createSnapshotArgsForCall[i].instanceName, fake.createSnapshotArgsForCall[i].snapshotName
+}
+
+func (fake *FakeApi) CreateSnapshotReturns(result1 error) {
+	fake.CreateSnapshotStub = nil
+	fake.createSnapshotReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeApi) CreateSnapshotReturnsOnCall(i int, result1 error) {
+	fake.CreateSnapshotStub = nil
+	if fake.createSnapshotReturnsOnCall == nil {
+		fake.createSnapshotReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createSnapshotReturnsOnCall[i] = struct {
+		result1 error
 	}{result1}
 }
 
+func (fake *FakeApi) ListSnapshots(instanceName string) ([]*api.DBSnapshot, error) {
+	fake.listSnapshotsMutex.Lock()
+	ret, specificReturn := fake.listSnapshotsReturnsOnCall[len(fake.listSnapshotsArgsForCall)]
+	fake.listSnapshotsArgsForCall = append(fake.listSnapshotsArgsForCall, struct {
+		instanceName string
+	}{instanceName})
+	fake.recordInvocation("ListSnapshots", []interface{}{instanceName})
+	fake.listSnapshotsMutex.Unlock()
+	if fake.ListSnapshotsStub != nil {
+		return fake.ListSnapshotsStub(instanceName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.listSnapshotsReturns.result1, fake.listSnapshotsReturns.result2
+}
+
+func (fake *FakeApi) ListSnapshotsCallCount() int {
+	fake.listSnapshotsMutex.RLock()
+	defer fake.listSnapshotsMutex.RUnlock()
+	return len(fake.listSnapshotsArgsForCall)
+}
+
+func (fake *FakeApi) ListSnapshotsArgsForCall(i int) string {
+	fake.listSnapshotsMutex.RLock()
+	defer fake.listSnapshotsMutex.RUnlock()
+	return fake.listSnapshotsArgsForCall[i].instanceName
+}
+
+func (fake *FakeApi) ListSnapshotsReturns(result1 []*api.DBSnapshot, result2 error) {
+	fake.ListSnapshotsStub = nil
+	fake.listSnapshotsReturns = struct {
+		result1 []*api.DBSnapshot
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApi) ListSnapshotsReturnsOnCall(i int, result1 []*api.DBSnapshot, result2 error) {
+	fake.ListSnapshotsStub = nil
+	if fake.listSnapshotsReturnsOnCall == nil {
+		fake.listSnapshotsReturnsOnCall = make(map[int]struct {
+			result1 []*api.DBSnapshot
+			result2 error
+		})
+	}
+	fake.listSnapshotsReturnsOnCall[i] = struct {
+		result1 []*api.DBSnapshot
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApi) DescribeSnapshot(snapshotName string) (*api.DBSnapshot, *api.DBInstance, error) {
+	fake.describeSnapshotMutex.Lock()
+	ret, specificReturn := fake.describeSnapshotReturnsOnCall[len(fake.describeSnapshotArgsForCall)]
+	fake.describeSnapshotArgsForCall = append(fake.describeSnapshotArgsForCall, struct {
+		snapshotName string
+	}{snapshotName})
+	fake.recordInvocation("DescribeSnapshot", []interface{}{snapshotName})
+	fake.describeSnapshotMutex.Unlock()
+	if fake.DescribeSnapshotStub != nil {
+		return fake.DescribeSnapshotStub(snapshotName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.describeSnapshotReturns.result1, fake.describeSnapshotReturns.result2, fake.describeSnapshotReturns.result3
+}
+
+func (fake *FakeApi) DescribeSnapshotCallCount() int {
+	fake.describeSnapshotMutex.RLock()
+	defer fake.describeSnapshotMutex.RUnlock()
+	return len(fake.describeSnapshotArgsForCall)
+}
+
+func (fake *FakeApi) DescribeSnapshotArgsForCall(i int) string {
+	fake.describeSnapshotMutex.RLock()
+	defer fake.describeSnapshotMutex.RUnlock()
+	return fake.describeSnapshotArgsForCall[i].snapshotName
+}
+
+func (fake *FakeApi) DescribeSnapshotReturns(result1 *api.DBSnapshot, result2 *api.DBInstance, result3 error) {
+	fake.DescribeSnapshotStub = nil
+	fake.describeSnapshotReturns = struct {
+		result1 *api.DBSnapshot
+		result2 *api.DBInstance
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeApi) DescribeSnapshotReturnsOnCall(i int, result1 *api.DBSnapshot, result2 *api.DBInstance, result3 error) {
+	fake.DescribeSnapshotStub = nil
+	if fake.describeSnapshotReturnsOnCall == nil {
+		fake.describeSnapshotReturnsOnCall = make(map[int]struct {
+			result1 *api.DBSnapshot
+			result2 *api.DBInstance
+			result3 error
+		})
+	}
+	fake.describeSnapshotReturnsOnCall[i] = struct {
+		result1 *api.DBSnapshot
+		result2 *api.DBInstance
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeApi) DeleteSnapshot(snapshotName string) error {
+	fake.deleteSnapshotMutex.Lock()
+	ret, specificReturn := fake.deleteSnapshotReturnsOnCall[len(fake.deleteSnapshotArgsForCall)]
+	fake.deleteSnapshotArgsForCall = append(fake.deleteSnapshotArgsForCall, struct {
+		snapshotName string
+	}{snapshotName})
+	fake.recordInvocation("DeleteSnapshot", []interface{}{snapshotName})
+	fake.deleteSnapshotMutex.Unlock()
+	if fake.DeleteSnapshotStub != nil {
+		return fake.DeleteSnapshotStub(snapshotName)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.deleteSnapshotReturns.result1
+}
+
+func (fake *FakeApi) DeleteSnapshotCallCount() int {
+	fake.deleteSnapshotMutex.RLock()
+	defer fake.deleteSnapshotMutex.RUnlock()
+	return len(fake.deleteSnapshotArgsForCall)
+}
+
+func (fake *FakeApi) DeleteSnapshotArgsForCall(i int) string {
+	fake.deleteSnapshotMutex.RLock()
+	defer fake.deleteSnapshotMutex.RUnlock()
+	return fake.deleteSnapshotArgsForCall[i].snapshotName
+}
+
+func (fake *FakeApi) DeleteSnapshotReturns(result1 error) {
+	fake.DeleteSnapshotStub = nil
+	fake.deleteSnapshotReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeApi) DeleteSnapshotReturnsOnCall(i int, result1 error) {
+	fake.DeleteSnapshotStub = nil
+	if fake.deleteSnapshotReturnsOnCall == nil {
+		fake.deleteSnapshotReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteSnapshotReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeApi) RestoreFromSnapshot(ctx context.Context, instance *api.DBInstance, snapshotName string) error {
+	fake.restoreFromSnapshotMutex.Lock()
+	ret, specificReturn := fake.restoreFromSnapshotReturnsOnCall[len(fake.restoreFromSnapshotArgsForCall)]
+	fake.restoreFromSnapshotArgsForCall = append(fake.restoreFromSnapshotArgsForCall, struct {
+		ctx          context.Context
+		instance     *api.DBInstance
+		snapshotName string
+	}{ctx, instance, snapshotName})
+	fake.recordInvocation("RestoreFromSnapshot", []interface{}{ctx, instance, snapshotName})
+	fake.restoreFromSnapshotMutex.Unlock()
+	if fake.RestoreFromSnapshotStub != nil {
+		return fake.RestoreFromSnapshotStub(ctx, instance, snapshotName)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.restoreFromSnapshotReturns.result1
+}
+
+func (fake *FakeApi) RestoreFromSnapshotCallCount() int {
+	fake.restoreFromSnapshotMutex.RLock()
+	defer fake.restoreFromSnapshotMutex.RUnlock()
+	return len(fake.restoreFromSnapshotArgsForCall)
+}
+
+func (fake *FakeApi) RestoreFromSnapshotArgsForCall(i int) (context.Context, *api.DBInstance, string) {
+	fake.restoreFromSnapshotMutex.RLock()
+	defer fake.restoreFromSnapshotMutex.RUnlock()
+	return fake.restoreFromSnapshotArgsForCall[i].ctx, fake.restoreFromSnapshotArgsForCall[i].instance, fake.restoreFromSnapshotArgsForCall[i].snapshotName
+}
+
+func (fake *FakeApi) RestoreFromSnapshotReturns(result1 error) {
+	fake.RestoreFromSnapshotStub = nil
+	fake.restoreFromSnapshotReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeApi) RestoreFromSnapshotReturnsOnCall(i int, result1 error) {
+	fake.RestoreFromSnapshotStub = nil
+	if fake.restoreFromSnapshotReturnsOnCall == nil {
+		fake.restoreFromSnapshotReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.restoreFromSnapshotReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeApi) RestoreToPointInTime(ctx context.Context, instance *api.DBInstance, sourceInstanceName string, restoreTime time.Time) error {
+	fake.restoreToPointInTimeMutex.Lock()
+	ret, specificReturn := fake.restoreToPointInTimeReturnsOnCall[len(fake.restoreToPointInTimeArgsForCall)]
+	fake.restoreToPointInTimeArgsForCall = append(fake.restoreToPointInTimeArgsForCall, struct {
+		ctx                context.Context
+		instance           *api.DBInstance
+		sourceInstanceName string
+		restoreTime        time.Time
+	}{ctx, instance, sourceInstanceName, restoreTime})
+	fake.recordInvocation("RestoreToPointInTime", []interface{}{ctx, instance, sourceInstanceName, restoreTime})
+	fake.restoreToPointInTimeMutex.Unlock()
+	if fake.RestoreToPointInTimeStub != nil {
+		return fake.RestoreToPointInTimeStub(ctx, instance, sourceInstanceName, restoreTime)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.restoreToPointInTimeReturns.result1
+}
+
+func (fake *FakeApi) RestoreToPointInTimeCallCount() int {
+	fake.restoreToPointInTimeMutex.RLock()
+	defer fake.restoreToPointInTimeMutex.RUnlock()
+	return len(fake.restoreToPointInTimeArgsForCall)
+}
+
+func (fake *FakeApi) RestoreToPointInTimeArgsForCall(i int) (context.Context, *api.DBInstance, string, time.Time) {
+	fake.restoreToPointInTimeMutex.RLock()
+	defer fake.restoreToPointInTimeMutex.RUnlock()
+	return fake.restoreToPointInTimeArgsForCall[i].ctx, fake.restoreToPointInTimeArgsForCall[i].instance, fake.restoreToPointInTimeArgsForCall[i].sourceInstanceName, fake.restoreToPointInTimeArgsForCall[i].restoreTime
+}
+
+func (fake *FakeApi) RestoreToPointInTimeReturns(result1 error) {
+	fake.RestoreToPointInTimeStub = nil
+	fake.restoreToPointInTimeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeApi) RestoreToPointInTimeReturnsOnCall(i int, result1 error) {
+	fake.RestoreToPointInTimeStub = nil
+	if fake.restoreToPointInTimeReturnsOnCall == nil {
+		fake.restoreToPointInTimeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.restoreToPointInTimeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeApi) ScaleInstance(instance *api.DBInstance, applyImmediately bool) error {
+	fake.scaleInstanceMutex.Lock()
+	ret, specificReturn := fake.scaleInstanceReturnsOnCall[len(fake.scaleInstanceArgsForCall)]
+	fake.scaleInstanceArgsForCall = append(fake.scaleInstanceArgsForCall, struct {
+		instance         *api.DBInstance
+		applyImmediately bool
+	}{instance, applyImmediately})
+	fake.recordInvocation("ScaleInstance", []interface{}{instance, applyImmediately})
+	fake.scaleInstanceMutex.Unlock()
+	if fake.ScaleInstanceStub != nil {
+		return fake.ScaleInstanceStub(instance, applyImmediately)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.scaleInstanceReturns.result1
+}
+
+func (fake *FakeApi) ScaleInstanceCallCount() int {
+	fake.scaleInstanceMutex.RLock()
+	defer fake.scaleInstanceMutex.RUnlock()
+	return len(fake.scaleInstanceArgsForCall)
+}
+
+func (fake *FakeApi) ScaleInstanceArgsForCall(i int) (*api.DBInstance, bool) {
+	fake.scaleInstanceMutex.RLock()
+	defer fake.scaleInstanceMutex.RUnlock()
+	return fake.scaleInstanceArgsForCall[i].instance, fake.scaleInstanceArgsForCall[i].applyImmediately
+}
+
+func (fake *FakeApi) ScaleInstanceReturns(result1 error) {
+	fake.ScaleInstanceStub = nil
+	fake.scaleInstanceReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeApi) ScaleInstanceReturnsOnCall(i int, result1 error) {
+	fake.ScaleInstanceStub = nil
+	if fake.scaleInstanceReturnsOnCall == nil {
+		fake.scaleInstanceReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.scaleInstanceReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeApi) WaitForScale(ctx context.Context, instance *api.DBInstance, applyImmediately bool) error {
+	fake.waitForScaleMutex.Lock()
+	ret, specificReturn := fake.waitForScaleReturnsOnCall[len(fake.waitForScaleArgsForCall)]
+	fake.waitForScaleArgsForCall = append(fake.waitForScaleArgsForCall, struct {
+		ctx              context.Context
+		instance         *api.DBInstance
+		applyImmediately bool
+	}{ctx, instance, applyImmediately})
+	fake.recordInvocation("WaitForScale", []interface{}{ctx, instance, applyImmediately})
+	fake.waitForScaleMutex.Unlock()
+	if fake.WaitForScaleStub != nil {
+		return fake.WaitForScaleStub(ctx, instance, applyImmediately)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.waitForScaleReturns.result1
+}
+
+func (fake *FakeApi) WaitForScaleCallCount() int {
+	fake.waitForScaleMutex.RLock()
+	defer fake.waitForScaleMutex.RUnlock()
+	return len(fake.waitForScaleArgsForCall)
+}
+
+func (fake *FakeApi) WaitForScaleArgsForCall(i int) (context.Context, *api.DBInstance, bool) {
+	fake.waitForScaleMutex.RLock()
+	defer fake.waitForScaleMutex.RUnlock()
+	return fake.waitForScaleArgsForCall[i].ctx, fake.waitForScaleArgsForCall[i].instance, fake.waitForScaleArgsForCall[i].applyImmediately
+}
+
+func (fake *FakeApi) WaitForScaleReturns(result1 error) {
+	fake.WaitForScaleStub = nil
+	fake.waitForScaleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeApi) WaitForScaleReturnsOnCall(i int, result1 error) {
+	fake.WaitForScaleStub = nil
+	if fake.waitForScaleReturnsOnCall == nil {
+		fake.waitForScaleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.waitForScaleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeApi) StopInstance(ctx context.Context, instanceName string) error {
+	fake.stopInstanceMutex.Lock()
+	ret, specificReturn := fake.stopInstanceReturnsOnCall[len(fake.stopInstanceArgsForCall)]
+	fake.stopInstanceArgsForCall = append(fake.stopInstanceArgsForCall, struct {
+		ctx          context.Context
+		instanceName string
+	}{ctx, instanceName})
+	fake.recordInvocation("StopInstance", []interface{}{ctx, instanceName})
+	fake.stopInstanceMutex.Unlock()
+	if fake.StopInstanceStub != nil {
+		return fake.StopInstanceStub(ctx, instanceName)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.stopInstanceReturns.result1
+}
+
+func (fake *FakeApi) StopInstanceCallCount() int {
+	fake.stopInstanceMutex.RLock()
+	defer fake.stopInstanceMutex.RUnlock()
+	return len(fake.stopInstanceArgsForCall)
+}
+
+func (fake *FakeApi) StopInstanceArgsForCall(i int) (context.Context, string) {
+	fake.stopInstanceMutex.RLock()
+	defer fake.stopInstanceMutex.RUnlock()
+	return fake.stopInstanceArgsForCall[i].ctx, fake.stopInstanceArgsForCall[i].instanceName
+}
+
+func (fake *FakeApi) StopInstanceReturns(result1 error) {
+	fake.StopInstanceStub = nil
+	fake.stopInstanceReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeApi) StopInstanceReturnsOnCall(i int, result1 error) {
+	fake.StopInstanceStub = nil
+	if fake.stopInstanceReturnsOnCall == nil {
+		fake.stopInstanceReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.stopInstanceReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeApi) StartInstance(ctx context.Context, instanceName string) error {
+	fake.startInstanceMutex.Lock()
+	ret, specificReturn := fake.startInstanceReturnsOnCall[len(fake.startInstanceArgsForCall)]
+	fake.startInstanceArgsForCall = append(fake.startInstanceArgsForCall, struct {
+		ctx          context.Context
+		instanceName string
+	}{ctx, instanceName})
+	fake.recordInvocation("StartInstance", []interface{}{ctx, instanceName})
+	fake.startInstanceMutex.Unlock()
+	if fake.StartInstanceStub != nil {
+		return fake.StartInstanceStub(ctx, instanceName)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.startInstanceReturns.result1
+}
+
+func (fake *FakeApi) StartInstanceCallCount() int {
+	fake.startInstanceMutex.RLock()
+	defer fake.startInstanceMutex.RUnlock()
+	return len(fake.startInstanceArgsForCall)
+}
+
+func (fake *FakeApi) StartInstanceArgsForCall(i int) (context.Context, string) {
+	fake.startInstanceMutex.RLock()
+	defer fake.startInstanceMutex.RUnlock()
+	return fake.startInstanceArgsForCall[i].ctx, fake.startInstanceArgsForCall[i].instanceName
+}
+
+func (fake *FakeApi) StartInstanceReturns(result1 error) {
+	fake.StartInstanceStub = nil
+	fake.startInstanceReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeApi) StartInstanceReturnsOnCall(i int, result1 error) {
+	fake.StartInstanceStub = nil
+	if fake.startInstanceReturnsOnCall == nil {
+		fake.startInstanceReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.startInstanceReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeApi) RebootInstance(ctx context.Context, instanceName string, forceFailover bool) error {
+	fake.rebootInstanceMutex.Lock()
+	ret, specificReturn := fake.rebootInstanceReturnsOnCall[len(fake.rebootInstanceArgsForCall)]
+	fake.rebootInstanceArgsForCall = append(fake.rebootInstanceArgsForCall, struct {
+		ctx           context.Context
+		instanceName  string
+		forceFailover bool
+	}{ctx, instanceName, forceFailover})
+	fake.recordInvocation("RebootInstance", []interface{}{ctx, instanceName, forceFailover})
+	fake.rebootInstanceMutex.Unlock()
+	if fake.RebootInstanceStub != nil {
+		return fake.RebootInstanceStub(ctx, instanceName, forceFailover)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.rebootInstanceReturns.result1
+}
+
+func (fake *FakeApi) RebootInstanceCallCount() int {
+	fake.rebootInstanceMutex.RLock()
+	defer fake.rebootInstanceMutex.RUnlock()
+	return len(fake.rebootInstanceArgsForCall)
+}
+
+func (fake *FakeApi) RebootInstanceArgsForCall(i int) (context.Context, string, bool) {
+	fake.rebootInstanceMutex.RLock()
+	defer fake.rebootInstanceMutex.RUnlock()
+	return fake.rebootInstanceArgsForCall[i].ctx, fake.rebootInstanceArgsForCall[i].instanceName, fake.rebootInstanceArgsForCall[i].forceFailover
+}
+
+func (fake *FakeApi) RebootInstanceReturns(result1 error) {
+	fake.RebootInstanceStub = nil
+	fake.rebootInstanceReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeApi) RebootInstanceReturnsOnCall(i int, result1 error) {
+	fake.RebootInstanceStub = nil
+	if fake.rebootInstanceReturnsOnCall == nil {
+		fake.rebootInstanceReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.rebootInstanceReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeApi) CreateSecurityGroup(serviceName string, vpc string, port int64, cidrs []string, tags map[string]string) (string, error) {
+	fake.createSecurityGroupMutex.Lock()
+	ret, specificReturn := fake.createSecurityGroupReturnsOnCall[len(fake.createSecurityGroupArgsForCall)]
+	fake.createSecurityGroupArgsForCall = append(fake.createSecurityGroupArgsForCall, struct {
+		serviceName string
+		vpc         string
+		port        int64
+		cidrs       []string
+		tags        map[string]string
+	}{serviceName, vpc, port, cidrs, tags})
+	fake.recordInvocation("CreateSecurityGroup", []interface{}{serviceName, vpc, port, cidrs, tags})
+	fake.createSecurityGroupMutex.Unlock()
+	if fake.CreateSecurityGroupStub != nil {
+		return fake.CreateSecurityGroupStub(serviceName, vpc, port, cidrs, tags)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.createSecurityGroupReturns.result1, fake.createSecurityGroupReturns.result2
+}
+
+func (fake *FakeApi) CreateSecurityGroupCallCount() int {
+	fake.createSecurityGroupMutex.RLock()
+	defer fake.createSecurityGroupMutex.RUnlock()
+	return len(fake.createSecurityGroupArgsForCall)
+}
+
+func (fake *FakeApi) CreateSecurityGroupArgsForCall(i int) (string, string, int64, []string, map[string]string) {
+	fake.createSecurityGroupMutex.RLock()
+	defer fake.createSecurityGroupMutex.RUnlock()
+	return fake.createSecurityGroupArgsForCall[i].serviceName, fake.createSecurityGroupArgsForCall[i].vpc, fake.createSecurityGroupArgsForCall[i].port, fake.createSecurityGroupArgsForCall[i].cidrs, fake.createSecurityGroupArgsForCall[i].tags
+}
+
+func (fake *FakeApi) CreateSecurityGroupReturns(result1 string, result2 error) {
+	fake.CreateSecurityGroupStub = nil
+	fake.createSecurityGroupReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApi) CreateSecurityGroupReturnsOnCall(i int, result1 string, result2 error) {
+	fake.CreateSecurityGroupStub = nil
+	if fake.createSecurityGroupReturnsOnCall == nil {
+		fake.createSecurityGroupReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.createSecurityGroupReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApi) DeleteSecurityGroup(groupID string) error {
+	fake.deleteSecurityGroupMutex.Lock()
+	ret, specificReturn := fake.deleteSecurityGroupReturnsOnCall[len(fake.deleteSecurityGroupArgsForCall)]
+	fake.deleteSecurityGroupArgsForCall = append(fake.deleteSecurityGroupArgsForCall, struct {
+		groupID string
+	}{groupID})
+	fake.recordInvocation("DeleteSecurityGroup", []interface{}{groupID})
+	fake.deleteSecurityGroupMutex.Unlock()
+	if fake.DeleteSecurityGroupStub != nil {
+		return fake.DeleteSecurityGroupStub(groupID)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.deleteSecurityGroupReturns.result1
+}
+
+func (fake *FakeApi) DeleteSecurityGroupCallCount() int {
+	fake.deleteSecurityGroupMutex.RLock()
+	defer fake.deleteSecurityGroupMutex.RUnlock()
+	return len(fake.deleteSecurityGroupArgsForCall)
+}
+
+func (fake *FakeApi) DeleteSecurityGroupArgsForCall(i int) string {
+	fake.deleteSecurityGroupMutex.RLock()
+	defer fake.deleteSecurityGroupMutex.RUnlock()
+	return fake.deleteSecurityGroupArgsForCall[i].groupID
+}
+
+func (fake *FakeApi) DeleteSecurityGroupReturns(result1 error) {
+	fake.DeleteSecurityGroupStub = nil
+	fake.deleteSecurityGroupReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeApi) DeleteSecurityGroupReturnsOnCall(i int, result1 error) {
+	fake.DeleteSecurityGroupStub = nil
+	if fake.deleteSecurityGroupReturnsOnCall == nil {
+		fake.deleteSecurityGroupReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteSecurityGroupReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeApi) DeleteSecurityGroups(ctx context.Context, tags map[string]string) error {
+	fake.deleteSecurityGroupsMutex.Lock()
+	ret, specificReturn := fake.deleteSecurityGroupsReturnsOnCall[len(fake.deleteSecurityGroupsArgsForCall)]
+	fake.deleteSecurityGroupsArgsForCall = append(fake.deleteSecurityGroupsArgsForCall, struct {
+		ctx  context.Context
+		tags map[string]string
+	}{ctx, tags})
+	fake.recordInvocation("DeleteSecurityGroups", []interface{}{ctx, tags})
+	fake.deleteSecurityGroupsMutex.Unlock()
+	if fake.DeleteSecurityGroupsStub != nil {
+		return fake.DeleteSecurityGroupsStub(ctx, tags)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.deleteSecurityGroupsReturns.result1
+}
+
+func (fake *FakeApi) DeleteSecurityGroupsCallCount() int {
+	fake.deleteSecurityGroupsMutex.RLock()
+	defer fake.deleteSecurityGroupsMutex.RUnlock()
+	return len(fake.deleteSecurityGroupsArgsForCall)
+}
+
+func (fake *FakeApi) DeleteSecurityGroupsArgsForCall(i int) (context.Context, map[string]string) {
+	fake.deleteSecurityGroupsMutex.RLock()
+	defer fake.deleteSecurityGroupsMutex.RUnlock()
+	return fake.deleteSecurityGroupsArgsForCall[i].ctx, fake.deleteSecurityGroupsArgsForCall[i].tags
+}
+
+func (fake *FakeApi) DeleteSecurityGroupsReturns(result1 error) {
+	fake.DeleteSecurityGroupsStub = nil
+	fake.deleteSecurityGroupsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeApi) DeleteSecurityGroupsReturnsOnCall(i int, result1 error) {
+	fake.DeleteSecurityGroupsStub = nil
+	if fake.deleteSecurityGroupsReturnsOnCall == nil {
+		fake.deleteSecurityGroupsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteSecurityGroupsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeApi) CreateReplica(ctx context.Context, replica *api.DBInstance, source string) error {
+	fake.createReplicaMutex.Lock()
+	ret, specificReturn := fake.createReplicaReturnsOnCall[len(fake.createReplicaArgsForCall)]
+	fake.createReplicaArgsForCall = append(fake.createReplicaArgsForCall, struct {
+		ctx     context.Context
+		replica *api.DBInstance
+		source  string
+	}{ctx, replica, source})
+	fake.recordInvocation("CreateReplica", []interface{}{ctx, replica, source})
+	fake.createReplicaMutex.Unlock()
+	if fake.CreateReplicaStub != nil {
+		return fake.CreateReplicaStub(ctx, replica, source)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.createReplicaReturns.result1
+}
+
+func (fake *FakeApi) CreateReplicaCallCount() int {
+	fake.createReplicaMutex.RLock()
+	defer fake.createReplicaMutex.RUnlock()
+	return len(fake.createReplicaArgsForCall)
+}
+
+func (fake *FakeApi) CreateReplicaArgsForCall(i int) (context.Context, *api.DBInstance, string) {
+	fake.createReplicaMutex.RLock()
+	defer fake.createReplicaMutex.RUnlock()
+	return fake.createReplicaArgsForCall[i].ctx, fake.createReplicaArgsForCall[i].replica, fake.createReplicaArgsForCall[i].source
+}
+
+func (fake *FakeApi) CreateReplicaReturns(result1 error) {
+	fake.CreateReplicaStub = nil
+	fake.createReplicaReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeApi) CreateReplicaReturnsOnCall(i int, result1 error) {
+	fake.CreateReplicaStub = nil
+	if fake.createReplicaReturnsOnCall == nil {
+		fake.createReplicaReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createReplicaReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeApi) PromoteReplica(ctx context.Context, instance *api.DBInstance) error {
+	fake.promoteReplicaMutex.Lock()
+	ret, specificReturn := fake.promoteReplicaReturnsOnCall[len(fake.promoteReplicaArgsForCall)]
+	fake.promoteReplicaArgsForCall = append(fake.promoteReplicaArgsForCall, struct {
+		ctx      context.Context
+		instance *api.DBInstance
+	}{ctx, instance})
+	fake.recordInvocation("PromoteReplica", []interface{}{ctx, instance})
+	fake.promoteReplicaMutex.Unlock()
+	if fake.PromoteReplicaStub != nil {
+		return fake.PromoteReplicaStub(ctx, instance)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.promoteReplicaReturns.result1
+}
+
+func (fake *FakeApi) PromoteReplicaCallCount() int {
+	fake.promoteReplicaMutex.RLock()
+	defer fake.promoteReplicaMutex.RUnlock()
+	return len(fake.promoteReplicaArgsForCall)
+}
+
+func (fake *FakeApi) PromoteReplicaArgsForCall(i int) (context.Context, *api.DBInstance) {
+	fake.promoteReplicaMutex.RLock()
+	defer fake.promoteReplicaMutex.RUnlock()
+	return fake.promoteReplicaArgsForCall[i].ctx, fake.promoteReplicaArgsForCall[i].instance
+}
+
+func (fake *FakeApi) PromoteReplicaReturns(result1 error) {
+	fake.PromoteReplicaStub = nil
+	fake.promoteReplicaReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeApi) PromoteReplicaReturnsOnCall(i int, result1 error) {
+	fake.PromoteReplicaStub = nil
+	if fake.promoteReplicaReturnsOnCall == nil {
+		fake.promoteReplicaReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.promoteReplicaReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeApi) SetParameters(instanceName string, parameters map[string]string) ([]string, error) {
+	fake.setParametersMutex.Lock()
+	ret, specificReturn := fake.setParametersReturnsOnCall[len(fake.setParametersArgsForCall)]
+	fake.setParametersArgsForCall = append(fake.setParametersArgsForCall, struct {
+		instanceName string
+		parameters   map[string]string
+	}{instanceName, parameters})
+	fake.recordInvocation("SetParameters", []interface{}{instanceName, parameters})
+	fake.setParametersMutex.Unlock()
+	if fake.SetParametersStub != nil {
+		return fake.SetParametersStub(instanceName, parameters)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.setParametersReturns.result1, fake.setParametersReturns.result2
+}
+
+func (fake *FakeApi) SetParametersCallCount() int {
+	fake.setParametersMutex.RLock()
+	defer fake.setParametersMutex.RUnlock()
+	return len(fake.setParametersArgsForCall)
+}
+
+func (fake *FakeApi) SetParametersArgsForCall(i int) (string, map[string]string) {
+	fake.setParametersMutex.RLock()
+	defer fake.setParametersMutex.RUnlock()
+	return fake.setParametersArgsForCall[i].instanceName, fake.setParametersArgsForCall[i].parameters
+}
+
+func (fake *FakeApi) SetParametersReturns(result1 []string, result2 error) {
+	fake.SetParametersStub = nil
+	fake.setParametersReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApi) SetParametersReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.SetParametersStub = nil
+	if fake.setParametersReturnsOnCall == nil {
+		fake.setParametersReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.setParametersReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApi) DeleteParameterGroup(serviceName string) error {
+	fake.deleteParameterGroupMutex.Lock()
+	ret, specificReturn := fake.deleteParameterGroupReturnsOnCall[len(fake.deleteParameterGroupArgsForCall)]
+	fake.deleteParameterGroupArgsForCall = append(fake.deleteParameterGroupArgsForCall, struct {
+		serviceName string
+	}{serviceName})
+	fake.recordInvocation("DeleteParameterGroup", []interface{}{serviceName})
+	fake.deleteParameterGroupMutex.Unlock()
+	if fake.DeleteParameterGroupStub != nil {
+		return fake.DeleteParameterGroupStub(serviceName)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.deleteParameterGroupReturns.result1
+}
+
+func (fake *FakeApi) DeleteParameterGroupCallCount() int {
+	fake.deleteParameterGroupMutex.RLock()
+	defer fake.deleteParameterGroupMutex.RUnlock()
+	return len(fake.deleteParameterGroupArgsForCall)
+}
+
+func (fake *FakeApi) DeleteParameterGroupArgsForCall(i int) string {
+	fake.deleteParameterGroupMutex.RLock()
+	defer fake.deleteParameterGroupMutex.RUnlock()
+	return fake.deleteParameterGroupArgsForCall[i].serviceName
+}
+
+func (fake *FakeApi) DeleteParameterGroupReturns(result1 error) {
+	fake.DeleteParameterGroupStub = nil
+	fake.deleteParameterGroupReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeApi) DeleteParameterGroupReturnsOnCall(i int, result1 error) {
+	fake.DeleteParameterGroupStub = nil
+	if fake.deleteParameterGroupReturnsOnCall == nil {
+		fake.deleteParameterGroupReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteParameterGroupReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeApi) FindInstance(instanceName string, tags map[string]string) (*api.DBInstance, error) {
+	fake.findInstanceMutex.Lock()
+	ret, specificReturn := fake.findInstanceReturnsOnCall[len(fake.findInstanceArgsForCall)]
+	fake.findInstanceArgsForCall = append(fake.findInstanceArgsForCall, struct {
+		instanceName string
+		tags         map[string]string
+	}{instanceName, tags})
+	fake.recordInvocation("FindInstance", []interface{}{instanceName, tags})
+	fake.findInstanceMutex.Unlock()
+	if fake.FindInstanceStub != nil {
+		return fake.FindInstanceStub(instanceName, tags)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.findInstanceReturns.result1, fake.findInstanceReturns.result2
+}
+
+func (fake *FakeApi) FindInstanceCallCount() int {
+	fake.findInstanceMutex.RLock()
+	defer fake.findInstanceMutex.RUnlock()
+	return len(fake.findInstanceArgsForCall)
+}
+
+func (fake *FakeApi) FindInstanceArgsForCall(i int) (string, map[string]string) {
+	fake.findInstanceMutex.RLock()
+	defer fake.findInstanceMutex.RUnlock()
+	return fake.findInstanceArgsForCall[i].instanceName, fake.findInstanceArgsForCall[i].tags
+}
+
+func (fake *FakeApi) FindInstanceReturns(result1 *api.DBInstance, result2 error) {
+	fake.FindInstanceStub = nil
+	fake.findInstanceReturns = struct {
+		result1 *api.DBInstance
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApi) FindInstanceReturnsOnCall(i int, result1 *api.DBInstance, result2 error) {
+	fake.FindInstanceStub = nil
+	if fake.findInstanceReturnsOnCall == nil {
+		fake.findInstanceReturnsOnCall = make(map[int]struct {
+			result1 *api.DBInstance
+			result2 error
+		})
+	}
+	fake.findInstanceReturnsOnCall[i] = struct {
+		result1 *api.DBInstance
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApi) SetUpIAMAuth(ctx context.Context, instance *api.DBInstance, roleARN string) error {
+	fake.setUpIAMAuthMutex.Lock()
+	ret, specificReturn := fake.setUpIAMAuthReturnsOnCall[len(fake.setUpIAMAuthArgsForCall)]
+	fake.setUpIAMAuthArgsForCall = append(fake.setUpIAMAuthArgsForCall, struct {
+		ctx      context.Context
+		instance *api.DBInstance
+		roleARN  string
+	}{ctx, instance, roleARN})
+	fake.recordInvocation("SetUpIAMAuth", []interface{}{ctx, instance, roleARN})
+	fake.setUpIAMAuthMutex.Unlock()
+	if fake.SetUpIAMAuthStub != nil {
+		return fake.SetUpIAMAuthStub(ctx, instance, roleARN)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.setUpIAMAuthReturns.result1
+}
+
+func (fake *FakeApi) SetUpIAMAuthCallCount() int {
+	fake.setUpIAMAuthMutex.RLock()
+	defer fake.setUpIAMAuthMutex.RUnlock()
+	return len(fake.setUpIAMAuthArgsForCall)
+}
+
+func (fake *FakeApi) SetUpIAMAuthArgsForCall(i int) (context.Context, *api.DBInstance, string) {
+	fake.setUpIAMAuthMutex.RLock()
+	defer fake.setUpIAMAuthMutex.RUnlock()
+	return fake.setUpIAMAuthArgsForCall[i].ctx, fake.setUpIAMAuthArgsForCall[i].instance, fake.setUpIAMAuthArgsForCall[i].roleARN
+}
+
+func (fake *FakeApi) SetUpIAMAuthReturns(result1 error) {
+	fake.SetUpIAMAuthStub = nil
+	fake.setUpIAMAuthReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeApi) SetUpIAMAuthReturnsOnCall(i int, result1 error) {
+	fake.SetUpIAMAuthStub = nil
+	if fake.setUpIAMAuthReturnsOnCall == nil {
+		fake.setUpIAMAuthReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setUpIAMAuthReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeApi) RevokeIAMAuth(instanceName string, roleARN string) error {
+	fake.revokeIAMAuthMutex.Lock()
+	ret, specificReturn := fake.revokeIAMAuthReturnsOnCall[len(fake.revokeIAMAuthArgsForCall)]
+	fake.revokeIAMAuthArgsForCall = append(fake.revokeIAMAuthArgsForCall, struct {
+		instanceName string
+		roleARN      string
+	}{instanceName, roleARN})
+	fake.recordInvocation("RevokeIAMAuth", []interface{}{instanceName, roleARN})
+	fake.revokeIAMAuthMutex.Unlock()
+	if fake.RevokeIAMAuthStub != nil {
+		return fake.RevokeIAMAuthStub(instanceName, roleARN)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.revokeIAMAuthReturns.result1
+}
+
+func (fake *FakeApi) RevokeIAMAuthCallCount() int {
+	fake.revokeIAMAuthMutex.RLock()
+	defer fake.revokeIAMAuthMutex.RUnlock()
+	return len(fake.revokeIAMAuthArgsForCall)
+}
+
+func (fake *FakeApi) RevokeIAMAuthArgsForCall(i int) (string, string) {
+	fake.revokeIAMAuthMutex.RLock()
+	defer fake.revokeIAMAuthMutex.RUnlock()
+	return fake.revokeIAMAuthArgsForCall[i].instanceName, fake.revokeIAMAuthArgsForCall[i].roleARN
+}
+
+func (fake *FakeApi) RevokeIAMAuthReturns(result1 error) {
+	fake.RevokeIAMAuthStub = nil
+	fake.revokeIAMAuthReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeApi) RevokeIAMAuthReturnsOnCall(i int, result1 error) {
+	fake.RevokeIAMAuthStub = nil
+	if fake.revokeIAMAuthReturnsOnCall == nil {
+		fake.revokeIAMAuthReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.revokeIAMAuthReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeApi) AuthToken(credentials *api.DBInstance) (string, error) {
+	fake.authTokenMutex.Lock()
+	ret, specificReturn := fake.authTokenReturnsOnCall[len(fake.authTokenArgsForCall)]
+	fake.authTokenArgsForCall = append(fake.authTokenArgsForCall, struct {
+		credentials *api.DBInstance
+	}{credentials})
+	fake.recordInvocation("AuthToken", []interface{}{credentials})
+	fake.authTokenMutex.Unlock()
+	if fake.AuthTokenStub != nil {
+		return fake.AuthTokenStub(credentials)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.authTokenReturns.result1, fake.authTokenReturns.result2
+}
+
+func (fake *FakeApi) AuthTokenCallCount() int {
+	fake.authTokenMutex.RLock()
+	defer fake.authTokenMutex.RUnlock()
+	return len(fake.authTokenArgsForCall)
+}
+
+func (fake *FakeApi) AuthTokenArgsForCall(i int) *api.DBInstance {
+	fake.authTokenMutex.RLock()
+	defer fake.authTokenMutex.RUnlock()
+	return fake.authTokenArgsForCall[i].credentials
+}
+
+func (fake *FakeApi) AuthTokenReturns(result1 string, result2 error) {
+	fake.AuthTokenStub = nil
+	fake.authTokenReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApi) AuthTokenReturnsOnCall(i int, result1 string, result2 error) {
+	fake.AuthTokenStub = nil
+	if fake.authTokenReturnsOnCall == nil {
+		fake.authTokenReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.authTokenReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeApi) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -200,6 +1902,60 @@ func (fake *FakeApi) Invocations() map[string][][]interface{} {
 	defer fake.createInstanceMutex.RUnlock()
 	fake.refreshInstanceMutex.RLock()
 	defer fake.refreshInstanceMutex.RUnlock()
+	fake.deleteInstanceMutex.RLock()
+	defer fake.deleteInstanceMutex.RUnlock()
+	fake.waitForDeletionMutex.RLock()
+	defer fake.waitForDeletionMutex.RUnlock()
+	fake.listInstancesMutex.RLock()
+	defer fake.listInstancesMutex.RUnlock()
+	fake.describeInstanceMutex.RLock()
+	defer fake.describeInstanceMutex.RUnlock()
+	fake.resetPasswordMutex.RLock()
+	defer fake.resetPasswordMutex.RUnlock()
+	fake.createSnapshotMutex.RLock()
+	defer fake.createSnapshotMutex.RUnlock()
+	fake.listSnapshotsMutex.RLock()
+	defer fake.listSnapshotsMutex.RUnlock()
+	fake.describeSnapshotMutex.RLock()
+	defer fake.describeSnapshotMutex.RUnlock()
+	fake.deleteSnapshotMutex.RLock()
+	defer fake.deleteSnapshotMutex.RUnlock()
+	fake.restoreFromSnapshotMutex.RLock()
+	defer fake.restoreFromSnapshotMutex.RUnlock()
+	fake.restoreToPointInTimeMutex.RLock()
+	defer fake.restoreToPointInTimeMutex.RUnlock()
+	fake.scaleInstanceMutex.RLock()
+	defer fake.scaleInstanceMutex.RUnlock()
+	fake.waitForScaleMutex.RLock()
+	defer fake.waitForScaleMutex.RUnlock()
+	fake.stopInstanceMutex.RLock()
+	defer fake.stopInstanceMutex.RUnlock()
+	fake.startInstanceMutex.RLock()
+	defer fake.startInstanceMutex.RUnlock()
+	fake.rebootInstanceMutex.RLock()
+	defer fake.rebootInstanceMutex.RUnlock()
+	fake.createSecurityGroupMutex.RLock()
+	defer fake.createSecurityGroupMutex.RUnlock()
+	fake.deleteSecurityGroupMutex.RLock()
+	defer fake.deleteSecurityGroupMutex.RUnlock()
+	fake.deleteSecurityGroupsMutex.RLock()
+	defer fake.deleteSecurityGroupsMutex.RUnlock()
+	fake.createReplicaMutex.RLock()
+	defer fake.createReplicaMutex.RUnlock()
+	fake.promoteReplicaMutex.RLock()
+	defer fake.promoteReplicaMutex.RUnlock()
+	fake.setParametersMutex.RLock()
+	defer fake.setParametersMutex.RUnlock()
+	fake.deleteParameterGroupMutex.RLock()
+	defer fake.deleteParameterGroupMutex.RUnlock()
+	fake.findInstanceMutex.RLock()
+	defer fake.findInstanceMutex.RUnlock()
+	fake.setUpIAMAuthMutex.RLock()
+	defer fake.setUpIAMAuthMutex.RUnlock()
+	fake.revokeIAMAuthMutex.RLock()
+	defer fake.revokeIAMAuthMutex.RUnlock()
+	fake.authTokenMutex.RLock()
+	defer fake.authTokenMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
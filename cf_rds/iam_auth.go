@@ -0,0 +1,86 @@
+package cf_rds
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	"code.cloudfoundry.org/cli/plugin"
+	"github.com/seattle-beach/cf-cli-rds-plugin/api"
+)
+
+var roleARNPattern = regexp.MustCompile(`^arn:aws[a-z-]*:iam::\d{12}:role/.+$`)
+
+// validateIAMAuth rejects --iam-auth without an IAM role to log in with, or
+// for engines without IAM database authentication.
+func validateIAMAuth(opts AwsRdsCreateOptions) error {
+	switch {
+	case !opts.IAMAuth && opts.IAMRole != "":
+		return usageError(errors.New("Incorrect Usage: --iam-role only applies with --iam-auth"))
+	case !opts.IAMAuth:
+		return nil
+	case opts.IAMRole == "":
+		return usageError(errors.New("Incorrect Usage: --iam-auth needs --iam-role ARN, the IAM role apps log in with"))
+	case !roleARNPattern.MatchString(opts.IAMRole):
+		return usageError(fmt.Errorf("Incorrect Usage: --iam-role must be the ARN of an IAM role, such as arn:aws:iam::123456789012:role/app, not %s", opts.IAMRole))
+	case opts.Private:
+		// The DB user is created over a connection from this machine.
+		return usageError(errors.New("Incorrect Usage: --iam-auth cannot be used with --private, as the plugin connects to the RDS instance to set up the DB user"))
+	}
+
+	engine, err := api.LookupEngine(opts.Engine)
+	if err != nil {
+		return usageError(err)
+	}
+	if engine.IAMAuth == nil {
+		return usageError(fmt.Errorf("Incorrect Usage: RDS engine %s does not support IAM database authentication", opts.Engine))
+	}
+	return nil
+}
+
+type AwsRdsTokenOptions struct {
+	ServiceName string
+}
+
+func (a *AwsRdsTokenOptions) SetServiceName(name string) {
+	a.ServiceName = name
+}
+
+func (c *BasicPlugin) AwsRdsTokenRun(cliConnection plugin.CliConnection, args []string) error {
+	opts := AwsRdsTokenOptions{}
+	err := c.getOptions(&opts, args)
+	if err != nil {
+		return err
+	}
+
+	credentials, err := c.getUPSCredentials(opts.ServiceName, cliConnection)
+	if err != nil {
+		c.UI.DisplayError(err)
+		return err
+	}
+	if credentials.RoleARN == "" {
+		err = fmt.Errorf("Error: service %s does not log in with IAM auth tokens; create it with `cf aws-rds-create --iam-auth` to do so", opts.ServiceName)
+		c.UI.DisplayError(err)
+		return err
+	}
+
+	token, err := c.Api.AuthToken(credentials)
+	if err != nil {
+		c.UI.DisplayError(err)
+		return err
+	}
+
+	if c.machineOutput() {
+		return c.displayDocument("token", TokenOutput{
+			Hostname: credentials.Address,
+			Port:     credentials.Port,
+			Username: credentials.Username,
+			Region:   credentials.Region,
+			Token:    token,
+		})
+	}
+	c.UI.DisplayText("{{.Token}}", map[string]interface{}{
+		"Token": token,
+	})
+	return nil
+}
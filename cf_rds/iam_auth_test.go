@@ -0,0 +1,340 @@
+package cf_rds_test
+
+import (
+	"context"
+	"errors"
+
+	"code.cloudfoundry.org/cli/plugin/models"
+	"code.cloudfoundry.org/cli/plugin/pluginfakes"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/seattle-beach/cf-cli-rds-plugin/api"
+	. "github.com/seattle-beach/cf-cli-rds-plugin/cf_rds"
+	"github.com/seattle-beach/cf-cli-rds-plugin/cf_rds/fakes"
+)
+
+var _ = Describe("IAM database authentication", func() {
+	var ui MockUi
+	var conn *pluginfakes.FakeCliConnection
+	var fakeApi *fakes.FakeApi
+	var p *BasicPlugin
+	var exitCode int
+
+	BeforeEach(func() {
+		conn = &pluginfakes.FakeCliConnection{}
+		ui = MockUi{}
+		fakeApi = &fakes.FakeApi{}
+		exitCode = 0
+
+		p = &BasicPlugin{
+			UI:  &ui,
+			Api: fakeApi,
+			Exit: func(code int) {
+				exitCode = code
+			},
+		}
+
+		conn.GetServiceReturns(plugin_models.GetService_Model{
+			Guid:           "service-guid",
+			Name:           "name",
+			IsUserProvided: true,
+		}, nil)
+		conn.CliCommandWithoutTerminalOutputReturns([]string{`{"entity": {"credentials": {
+			"hostname": "name.eu-west-1.rds.amazonaws.com",
+			"port": 5432,
+			"username": "app",
+			"region": "eu-west-1",
+			"role_arn": "arn:aws:iam::123456789012:role/app"
+		}}}`}, nil)
+	})
+
+	Describe("aws-rds-create --iam-auth", func() {
+		var args []string
+
+		BeforeEach(func() {
+			args = []string{"aws-rds-create", "name", "--iam-auth", "--iam-role", "arn:aws:iam::123456789012:role/app"}
+			fakeApi.GetSubnetGroupsReturns([]*api.SubnetGroup{{
+				DBSubnetGroup: &rds.DBSubnetGroup{
+					DBSubnetGroupName: aws.String("default-vpc-vpcid"),
+					VpcId:             aws.String("vpcid"),
+				},
+				AvailabilityZones: []string{"us-east-1d", "us-east-1e"},
+				ActiveSubnets:     2,
+			}}, nil)
+			fakeApi.CreateInstanceStub = func(ctx context.Context, instance *api.DBInstance) error {
+				instance.Address = "name.eu-west-1.rds.amazonaws.com"
+				instance.Port = 5432
+				instance.SecGroups = []*rds.VpcSecurityGroupMembership{{VpcSecurityGroupId: aws.String("vpcgroup")}}
+				return nil
+			}
+			fakeApi.SetUpIAMAuthStub = func(ctx context.Context, instance *api.DBInstance, roleARN string) error {
+				instance.Username = "app"
+				instance.Password = ""
+				instance.DBURI = ""
+				instance.JDBCURI = ""
+				instance.Region = "eu-west-1"
+				instance.RoleARN = roleARN
+				return nil
+			}
+		})
+
+		It("enables IAM database authentication on the instance", func() {
+			p.Run(conn, args)
+			_, instance := fakeApi.CreateInstanceArgsForCall(0)
+			Expect(instance.IAMAuth).To(BeTrue())
+		})
+
+		It("tags the instance with the IAM role for aws-rds-refresh", func() {
+			p.Run(conn, args)
+			_, instance := fakeApi.CreateInstanceArgsForCall(0)
+			Expect(instance.Tags).To(HaveKeyWithValue("cf-rds-iam-role", "arn:aws:iam::123456789012:role/app"))
+		})
+
+		It("sets up the DB user for the IAM role once the instance is available", func() {
+			p.Run(conn, args)
+			Expect(fakeApi.SetUpIAMAuthCallCount()).To(Equal(1))
+			_, instance, roleARN := fakeApi.SetUpIAMAuthArgsForCall(0)
+			Expect(instance.InstanceName).To(Equal("name"))
+			Expect(roleARN).To(Equal("arn:aws:iam::123456789012:role/app"))
+		})
+
+		It("stores no password in the user-provided service", func() {
+			p.Run(conn, args)
+			Expect(conn.CliCommandArgsForCall(0)).To(Equal([]string{"cups", "name", "-p", `{"hostname":"name.eu-west-1.rds.amazonaws.com","port":5432,"username":"app","region":"eu-west-1","role_arn":"arn:aws:iam::123456789012:role/app"}`}))
+		})
+
+		It("does not set up IAM auth without --iam-auth", func() {
+			p.Run(conn, []string{"aws-rds-create", "name"})
+			_, instance := fakeApi.CreateInstanceArgsForCall(0)
+			Expect(instance.IAMAuth).To(BeFalse())
+			Expect(fakeApi.SetUpIAMAuthCallCount()).To(Equal(0))
+		})
+
+		It("warns that --allow-cidr must admit this machine", func() {
+			p.Run(conn, append(args, "--allow-cidr", "203.0.113.0/24"))
+			Expect(ui.Texts[0]).To(Equal("Warning: --iam-auth sets up the DB user over a connection from this machine, so --allow-cidr must admit its IP too. Otherwise the create fails once the RDS instance is available; run `cf aws-rds-refresh {{.ServiceName}}` from a machine it admits."))
+			Expect(ui.TextData[0]).To(Equal(map[string]interface{}{"ServiceName": "name"}))
+			Expect(fakeApi.SetUpIAMAuthCallCount()).To(Equal(1))
+		})
+
+		It("does not warn about --allow-cidr without --iam-auth", func() {
+			p.Run(conn, []string{"aws-rds-create", "name", "--allow-cidr", "203.0.113.0/24"})
+			Expect(ui.Texts).NotTo(ContainElement(HavePrefix("Warning:")))
+		})
+
+		Context("error cases", func() {
+			It("rejects --private, as the DB user is set up from this machine", func() {
+				p.Run(conn, append(args, "--private"))
+				Expect(ui.Err).To(MatchError("Incorrect Usage: --iam-auth cannot be used with --private, as the plugin connects to the RDS instance to set up the DB user"))
+				Expect(exitCode).To(Equal(ExitUsage))
+				Expect(fakeApi.CreateInstanceCallCount()).To(Equal(0))
+			})
+
+			It("requires --iam-role", func() {
+				p.Run(conn, []string{"aws-rds-create", "name", "--iam-auth"})
+				Expect(ui.Err).To(MatchError("Incorrect Usage: --iam-auth needs --iam-role ARN, the IAM role apps log in with"))
+				Expect(exitCode).To(Equal(ExitUsage))
+				Expect(fakeApi.CreateInstanceCallCount()).To(Equal(0))
+			})
+
+			It("rejects --iam-role without --iam-auth", func() {
+				p.Run(conn, []string{"aws-rds-create", "name", "--iam-role", "arn:aws:iam::123456789012:role/app"})
+				Expect(ui.Err).To(MatchError("Incorrect Usage: --iam-role only applies with --iam-auth"))
+				Expect(exitCode).To(Equal(ExitUsage))
+			})
+
+			It("rejects --iam-role values that are not IAM role ARNs", func() {
+				p.Run(conn, []string{"aws-rds-create", "name", "--iam-auth", "--iam-role", "app"})
+				Expect(ui.Err).To(MatchError("Incorrect Usage: --iam-role must be the ARN of an IAM role, such as arn:aws:iam::123456789012:role/app, not app"))
+				Expect(exitCode).To(Equal(ExitUsage))
+			})
+
+			It("rejects engines without IAM database authentication", func() {
+				p.Run(conn, append(args, "--engine", "sqlserver-ex"))
+				Expect(ui.Err).To(MatchError("Incorrect Usage: RDS engine sqlserver-ex does not support IAM database authentication"))
+				Expect(exitCode).To(Equal(ExitUsage))
+				Expect(fakeApi.CreateInstanceCallCount()).To(Equal(0))
+			})
+
+			It("creates no service if the DB user cannot be set up", func() {
+				fakeApi.SetUpIAMAuthReturns(errors.New("Error: could not create DB user app on RDS instance name: dial tcp: i/o timeout"))
+				p.Run(conn, args)
+				Expect(ui.Err).To(MatchError("Error: could not create DB user app on RDS instance name: dial tcp: i/o timeout"))
+				Expect(conn.CliCommandCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("aws-rds-refresh", func() {
+		var args []string
+
+		BeforeEach(func() {
+			args = []string{"aws-rds-refresh", "name"}
+			fakeApi.RefreshInstanceStub = func(ctx context.Context, instance *api.DBInstance) error {
+				instance.Engine = "postgres"
+				instance.Address = "name.eu-west-1.rds.amazonaws.com"
+				instance.Port = 5432
+				instance.Username = "root"
+				instance.Password = "password2"
+				instance.SecGroups = []*rds.VpcSecurityGroupMembership{{VpcSecurityGroupId: aws.String("vpcgroup")}}
+				instance.SubnetGroup = &rds.DBSubnetGroup{VpcId: aws.String("vpcid")}
+				instance.IAMAuth = true
+				instance.Tags = map[string]string{
+					"cf-rds-iam-role": "arn:aws:iam::123456789012:role/app",
+				}
+				return nil
+			}
+			fakeApi.SetUpIAMAuthStub = func(ctx context.Context, instance *api.DBInstance, roleARN string) error {
+				instance.Username = "app"
+				instance.Password = ""
+				instance.Region = "eu-west-1"
+				instance.RoleARN = roleARN
+				return nil
+			}
+		})
+
+		It("refreshes the instance found by its tags", func() {
+			fakeApi.FindInstanceReturns(&api.DBInstance{InstanceName: "name-dev"}, nil)
+			p.Run(conn, args)
+			_, instance := fakeApi.RefreshInstanceArgsForCall(0)
+			Expect(instance.InstanceName).To(Equal("name-dev"))
+			Expect(conn.CliCommandArgsForCall(0)[:2]).To(Equal([]string{"cups", "name"}))
+		})
+
+		It("sets up the DB user of the IAM role the instance is tagged with again", func() {
+			p.Run(conn, args)
+			Expect(fakeApi.SetUpIAMAuthCallCount()).To(Equal(1))
+			_, _, roleARN := fakeApi.SetUpIAMAuthArgsForCall(0)
+			Expect(roleARN).To(Equal("arn:aws:iam::123456789012:role/app"))
+		})
+
+		It("stores no password in the user-provided service", func() {
+			p.Run(conn, args)
+			Expect(conn.CliCommandArgsForCall(0)).To(Equal([]string{"cups", "name", "-p", `{"hostname":"name.eu-west-1.rds.amazonaws.com","port":5432,"username":"app","region":"eu-west-1","role_arn":"arn:aws:iam::123456789012:role/app"}`}))
+		})
+
+		Context("error cases", func() {
+			It("creates no service if the DB user cannot be set up", func() {
+				fakeApi.SetUpIAMAuthStub = nil
+				fakeApi.SetUpIAMAuthReturns(errors.New("Error: could not create DB user app on RDS instance name: dial tcp: i/o timeout"))
+				p.Run(conn, args)
+				Expect(ui.Err).To(MatchError("Error: could not create DB user app on RDS instance name: dial tcp: i/o timeout"))
+				Expect(conn.CliCommandCallCount()).To(Equal(0))
+			})
+
+			It("creates no service if the instance does not name its IAM role", func() {
+				refresh := fakeApi.RefreshInstanceStub
+				fakeApi.RefreshInstanceStub = func(ctx context.Context, instance *api.DBInstance) error {
+					err := refresh(ctx, instance)
+					instance.Tags = map[string]string{}
+					return err
+				}
+				p.Run(conn, args)
+				Expect(ui.Err).To(MatchError("Error: RDS instance name logs in with IAM auth tokens but has no cf-rds-iam-role tag naming the IAM role apps log in with"))
+				Expect(fakeApi.SetUpIAMAuthCallCount()).To(Equal(0))
+				Expect(conn.CliCommandCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("aws-rds-token", func() {
+		var args []string
+
+		BeforeEach(func() {
+			args = []string{"aws-rds-token", "name"}
+			fakeApi.AuthTokenReturns("name.eu-west-1.rds.amazonaws.com:5432?Action=connect&DBUser=app&X-Amz-Signature=abc", nil)
+		})
+
+		It("generates a token for the credentials of the service", func() {
+			p.Run(conn, args)
+			Expect(conn.GetServiceArgsForCall(0)).To(Equal("name"))
+			Expect(fakeApi.AuthTokenArgsForCall(0)).To(Equal(&api.DBInstance{
+				Address:  "name.eu-west-1.rds.amazonaws.com",
+				Port:     5432,
+				Username: "app",
+				Region:   "eu-west-1",
+				RoleARN:  "arn:aws:iam::123456789012:role/app",
+			}))
+		})
+
+		It("displays the token", func() {
+			p.Run(conn, args)
+			Expect(ui.TextTemplate).To(Equal("{{.Token}}"))
+			Expect(ui.Data["Token"]).To(Equal("name.eu-west-1.rds.amazonaws.com:5432?Action=connect&DBUser=app&X-Amz-Signature=abc"))
+		})
+
+		It("displays the token with what it logs in to with --output json", func() {
+			p.Run(conn, append(args, "--output", "json"))
+			Expect(ui.TextTemplate).To(BeEmpty())
+			Expect(ui.Out.String()).To(MatchJSON(`{
+				"schema_version": 1,
+				"token": {
+					"hostname": "name.eu-west-1.rds.amazonaws.com",
+					"port": 5432,
+					"username": "app",
+					"region": "eu-west-1",
+					"token": "name.eu-west-1.rds.amazonaws.com:5432?Action=connect&DBUser=app&X-Amz-Signature=abc"
+				}
+			}`))
+		})
+
+		Context("error cases", func() {
+			It("rejects services that log in with a password", func() {
+				conn.CliCommandWithoutTerminalOutputReturns([]string{`{"entity": {"credentials": {"password": "password"}}}`}, nil)
+				p.Run(conn, args)
+				Expect(ui.Err).To(MatchError("Error: service name does not log in with IAM auth tokens; create it with `cf aws-rds-create --iam-auth` to do so"))
+				Expect(fakeApi.AuthTokenCallCount()).To(Equal(0))
+			})
+
+			It("exits with ExitCFCLI when the service cannot be read", func() {
+				conn.GetServiceReturns(plugin_models.GetService_Model{}, errors.New("not found"))
+				p.Run(conn, args)
+				Expect(ui.Err).To(MatchError("not found"))
+				Expect(exitCode).To(Equal(ExitCFCLI))
+			})
+
+			It("displays the error if the token cannot be signed", func() {
+				fakeApi.AuthTokenReturns("", api.ErrNoCredentials)
+				p.Run(conn, args)
+				Expect(ui.Err).To(Equal(api.ErrNoCredentials))
+			})
+		})
+	})
+
+	Describe("commands that need a password", func() {
+		It("does not rotate the credentials", func() {
+			p.Run(conn, []string{"aws-rds-rotate-credentials", "name"})
+			Expect(ui.Err).To(MatchError("Error: service name logs in with IAM auth tokens and holds no password to rotate"))
+			Expect(fakeApi.ResetPasswordCallCount()).To(Equal(0))
+		})
+
+		It("does not create a replica", func() {
+			p.Run(conn, []string{"aws-rds-create-replica", "name", "reporting"})
+			Expect(ui.Err).To(MatchError("Error: service name logs in with IAM auth tokens and holds no credentials for a replica to share"))
+			Expect(fakeApi.CreateReplicaCallCount()).To(Equal(0))
+		})
+	})
+
+	Describe("aws-rds-delete", func() {
+		BeforeEach(func() {
+			conn.GetServicesReturns([]plugin_models.GetServices_Model{{Name: "name"}}, nil)
+		})
+
+		It("revokes the access of the IAM role", func() {
+			p.Run(conn, []string{"aws-rds-delete", "name", "--skip-final-snapshot", "-f"})
+			Expect(fakeApi.RevokeIAMAuthCallCount()).To(Equal(1))
+			instanceName, roleARN := fakeApi.RevokeIAMAuthArgsForCall(0)
+			Expect(instanceName).To(Equal("name"))
+			Expect(roleARN).To(Equal("arn:aws:iam::123456789012:role/app"))
+		})
+
+		It("leaves IAM alone for services that log in with a password", func() {
+			conn.CliCommandWithoutTerminalOutputReturns([]string{`{"entity": {"credentials": {"password": "password"}}}`}, nil)
+			p.Run(conn, []string{"aws-rds-delete", "name", "--skip-final-snapshot", "-f"})
+			Expect(fakeApi.DeleteInstanceCallCount()).To(Equal(1))
+			Expect(fakeApi.RevokeIAMAuthCallCount()).To(Equal(0))
+		})
+	})
+})
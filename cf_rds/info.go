@@ -0,0 +1,183 @@
+package cf_rds
+
+import (
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/cli/plugin"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/seattle-beach/cf-cli-rds-plugin/api"
+)
+
+const maskedValue = "********"
+
+type AwsRdsInfoOptions struct {
+	ServiceName     string
+	ShowCredentials bool `long:"show-credentials" description:"Show the password stored in the user-provided service instead of masking it." required:"false"`
+}
+
+func (a *AwsRdsInfoOptions) SetServiceName(name string) {
+	a.ServiceName = name
+}
+
+func (c *BasicPlugin) AwsRdsInfoRun(cliConnection plugin.CliConnection, args []string) error {
+	opts := AwsRdsInfoOptions{}
+	err := c.getOptions(&opts, args)
+	if err != nil {
+		return err
+	}
+
+	instanceName, err := c.instanceName(cliConnection, opts.ServiceName)
+	if err != nil {
+		c.UI.DisplayError(err)
+		return err
+	}
+
+	instance, err := c.Api.DescribeInstance(instanceName)
+	if err != nil {
+		c.UI.DisplayError(err)
+		return err
+	}
+
+	credentials, credentialsErr := c.getUPSCredentials(opts.ServiceName, cliConnection)
+
+	if c.machineOutput() {
+		output := newInstanceOutput(instance)
+		if credentialsErr == nil {
+			output.Credentials = newCredentialsOutput(credentials, opts.ShowCredentials)
+		}
+		return c.displayDocument("instance", output)
+	}
+
+	table := [][]string{
+		{"Status:", instance.Status},
+		{"ARN:", instance.ARN},
+		{"RDSID:", instance.ResourceID},
+		{"Engine:", strings.TrimSpace(instance.Engine + " " + instance.EngineVersion)},
+		{"Class:", instance.InstanceClass},
+		{"Storage:", fmt.Sprintf("%d GB", instance.Storage)},
+		{"Multi-AZ:", yesNo(instance.MultiAZ)},
+		{"Publicly accessible:", yesNo(instance.PubliclyAccessible)},
+		{"Auto minor upgrades:", yesNo(instance.AutoMinorVersionUpgrade)},
+		{"Backup retention:", fmt.Sprintf("%d days", instance.BackupRetention)},
+		{"Maintenance window:", instance.MaintenanceWindow},
+		{"Parameter group:", parameterGroup(instance.ParameterGroup)},
+		{"Endpoint:", endpoint(instance)},
+		{"SecGroups:", securityGroups(instance.SecGroups)},
+		{"Subnet group:", subnetGroup(instance.SubnetGroup)},
+		{"Pending modifications:", pendingModifications(instance.PendingModifications)},
+	}
+
+	if credentialsErr != nil {
+		c.UI.DisplayText("Could not read the credentials of service {{.ServiceName}}: {{.Error}}", map[string]interface{}{
+			"ServiceName": opts.ServiceName,
+			"Error":       credentialsErr.Error(),
+		})
+	} else if credentials.RoleARN != "" {
+		table = append(table,
+			[]string{"Username:", credentials.Username},
+			[]string{"IAM role:", credentials.RoleARN},
+		)
+	} else {
+		password, uri := credentials.Password, credentials.DBURI
+		if !opts.ShowCredentials && password != "" {
+			uri = maskPassword(uri, password)
+			password = maskedValue
+		}
+		table = append(table,
+			[]string{"Username:", credentials.Username},
+			[]string{"Password:", password},
+			[]string{"Database:", credentials.DBName},
+			[]string{"URI:", uri},
+		)
+	}
+
+	c.UI.DisplayText("AWS RDS Instance:\n{{.instance}}", map[string]interface{}{
+		"instance": instance.InstanceName,
+	})
+	c.UI.DisplayKeyValueTable("", table, 2)
+
+	if instance.PubliclyAccessible {
+		c.UI.DisplayText("Warning: RDS instance {{.instance}} has a public endpoint. Anyone its security groups admit can connect to it from the internet; use `cf aws-rds-create --private` for instances only reached through the VPC.", map[string]interface{}{
+			"instance": instance.InstanceName,
+		})
+	}
+	return nil
+}
+
+func yesNo(value bool) string {
+	if value {
+		return "yes"
+	}
+	return "no"
+}
+
+func endpoint(instance *api.DBInstance) string {
+	if instance.Address == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s:%d", instance.Address, instance.Port)
+}
+
+func securityGroups(secGroups []*rds.VpcSecurityGroupMembership) string {
+	groups := []string{}
+	for _, secGroup := range secGroups {
+		groups = append(groups, fmt.Sprintf("%s (%s)", aws.StringValue(secGroup.VpcSecurityGroupId), aws.StringValue(secGroup.Status)))
+	}
+	return strings.Join(groups, ", ")
+}
+
+func subnetGroup(subnetGroup *rds.DBSubnetGroup) string {
+	if subnetGroup == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s (%s)", aws.StringValue(subnetGroup.DBSubnetGroupName), aws.StringValue(subnetGroup.VpcId))
+}
+
+func parameterGroup(parameterGroup *rds.DBParameterGroupStatus) string {
+	if parameterGroup == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s (%s)", aws.StringValue(parameterGroup.DBParameterGroupName), aws.StringValue(parameterGroup.ParameterApplyStatus))
+}
+
+func pendingModifications(pending *rds.PendingModifiedValues) string {
+	if pending == nil {
+		return "none"
+	}
+
+	modifications := []string{}
+	if pending.DBInstanceClass != nil {
+		modifications = append(modifications, "class "+*pending.DBInstanceClass)
+	}
+	if pending.AllocatedStorage != nil {
+		modifications = append(modifications, fmt.Sprintf("storage %d GB", *pending.AllocatedStorage))
+	}
+	if pending.Iops != nil {
+		modifications = append(modifications, fmt.Sprintf("iops %d", *pending.Iops))
+	}
+	if pending.StorageType != nil {
+		modifications = append(modifications, "storage type "+*pending.StorageType)
+	}
+	if pending.EngineVersion != nil {
+		modifications = append(modifications, "engine version "+*pending.EngineVersion)
+	}
+	if pending.MultiAZ != nil {
+		modifications = append(modifications, "multi-AZ "+yesNo(*pending.MultiAZ))
+	}
+	if pending.BackupRetentionPeriod != nil {
+		modifications = append(modifications, fmt.Sprintf("backup retention %d days", *pending.BackupRetentionPeriod))
+	}
+	if pending.Port != nil {
+		modifications = append(modifications, fmt.Sprintf("port %d", *pending.Port))
+	}
+	if pending.MasterUserPassword != nil {
+		modifications = append(modifications, "master password")
+	}
+
+	if len(modifications) == 0 {
+		return "none"
+	}
+	return strings.Join(modifications, ", ")
+}
@@ -0,0 +1,125 @@
+package cf_rds
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/cli/cf/errors"
+	"code.cloudfoundry.org/cli/plugin"
+)
+
+type AwsRdsStopOptions struct {
+	ServiceName string
+}
+
+func (a *AwsRdsStopOptions) SetServiceName(name string) {
+	a.ServiceName = name
+}
+
+func (c *BasicPlugin) AwsRdsStopRun(cliConnection plugin.CliConnection, args []string) error {
+	opts := AwsRdsStopOptions{}
+	err := c.getOptions(&opts, args)
+	if err != nil {
+		return err
+	}
+
+	instanceName, err := c.instanceName(cliConnection, opts.ServiceName)
+	if err != nil {
+		c.UI.DisplayError(err)
+		return err
+	}
+
+	c.UI.DisplayText("Stopping RDS Instance. This may take several minutes...")
+
+	return c.waitForLifecycle(opts.ServiceName,
+		"Successfully stopped RDS instance {{.ServiceName}}. RDS starts stopped instances again automatically after seven days.",
+		func(ctx context.Context) error {
+			return c.Api.StopInstance(ctx, instanceName)
+		})
+}
+
+type AwsRdsStartOptions struct {
+	ServiceName string
+}
+
+func (a *AwsRdsStartOptions) SetServiceName(name string) {
+	a.ServiceName = name
+}
+
+func (c *BasicPlugin) AwsRdsStartRun(cliConnection plugin.CliConnection, args []string) error {
+	opts := AwsRdsStartOptions{}
+	err := c.getOptions(&opts, args)
+	if err != nil {
+		return err
+	}
+
+	instanceName, err := c.instanceName(cliConnection, opts.ServiceName)
+	if err != nil {
+		c.UI.DisplayError(err)
+		return err
+	}
+
+	c.UI.DisplayText("Starting RDS Instance. This may take several minutes...")
+
+	return c.waitForLifecycle(opts.ServiceName,
+		"Successfully started RDS instance {{.ServiceName}}",
+		func(ctx context.Context) error {
+			return c.Api.StartInstance(ctx, instanceName)
+		})
+}
+
+type AwsRdsRebootOptions struct {
+	ServiceName   string
+	ForceFailover bool `long:"force-failover" description:"Fail over to the standby of a Multi-AZ instance while rebooting." required:"false"`
+}
+
+func (a *AwsRdsRebootOptions) SetServiceName(name string) {
+	a.ServiceName = name
+}
+
+func (c *BasicPlugin) AwsRdsRebootRun(cliConnection plugin.CliConnection, args []string) error {
+	opts := AwsRdsRebootOptions{}
+	err := c.getOptions(&opts, args)
+	if err != nil {
+		return err
+	}
+
+	instanceName, err := c.instanceName(cliConnection, opts.ServiceName)
+	if err != nil {
+		c.UI.DisplayError(err)
+		return err
+	}
+
+	if opts.ForceFailover {
+		instance, err := c.Api.DescribeInstance(instanceName)
+		if err != nil {
+			c.UI.DisplayError(err)
+			return err
+		}
+		if !instance.MultiAZ {
+			err = errors.New("Error: --force-failover can only be used with Multi-AZ instances")
+			c.UI.DisplayError(err)
+			return err
+		}
+	}
+
+	c.UI.DisplayText("Rebooting RDS Instance. This may take several minutes...")
+
+	return c.waitForLifecycle(opts.ServiceName,
+		"Successfully rebooted RDS instance {{.ServiceName}}",
+		func(ctx context.Context) error {
+			return c.Api.RebootInstance(ctx, instanceName, opts.ForceFailover)
+		})
+}
+
+func (c *BasicPlugin) waitForLifecycle(serviceName string, successTemplate string, operation func(ctx context.Context) error) error {
+	err := c.runOperation(infoHint(serviceName), operation)
+	if err != nil {
+		c.UI.DisplayError(err)
+		return err
+	}
+
+	c.UI.DisplayText(successTemplate, map[string]interface{}{
+		"ServiceName": serviceName,
+	})
+	return nil
+}
@@ -0,0 +1,102 @@
+package cf_rds_test
+
+import (
+	"context"
+	"errors"
+
+	"code.cloudfoundry.org/cli/plugin/pluginfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/seattle-beach/cf-cli-rds-plugin/api"
+	. "github.com/seattle-beach/cf-cli-rds-plugin/cf_rds"
+	"github.com/seattle-beach/cf-cli-rds-plugin/cf_rds/fakes"
+)
+
+var _ = Describe("lifecycle", func() {
+	var ui MockUi
+	var conn *pluginfakes.FakeCliConnection
+	var fakeApi *fakes.FakeApi
+	var p *BasicPlugin
+
+	BeforeEach(func() {
+		conn = &pluginfakes.FakeCliConnection{}
+		ui = MockUi{}
+		fakeApi = &fakes.FakeApi{}
+
+		p = &BasicPlugin{
+			UI:  &ui,
+			Api: fakeApi,
+		}
+	})
+
+	Describe("aws-rds-stop", func() {
+		It("stops the service's instance and waits until it is stopped", func() {
+			err := p.AwsRdsStopRun(conn, []string{"aws-rds-stop", "name"})
+			Expect(err).NotTo(HaveOccurred())
+			_, instanceName := fakeApi.StopInstanceArgsForCall(0)
+			Expect(instanceName).To(Equal("name"))
+			Expect(ui.TextTemplate).To(Equal("Successfully stopped RDS instance {{.ServiceName}}. RDS starts stopped instances again automatically after seven days."))
+		})
+
+		It("displays the progress the API reports", func() {
+			fakeApi.StopInstanceStub = func(ctx context.Context, instanceName string) error {
+				api.ReportProgress(ctx, "DB instance", instanceName, "stopping", -1)
+				api.ReportProgress(ctx, "DB instance", instanceName, "stopped", -1)
+				return nil
+			}
+			p.Run(conn, []string{"aws-rds-stop", "name"})
+			Expect(ui.Progress).To(HaveLen(2))
+			Expect(ui.Progress[0].Status).To(Equal("stopping"))
+			Expect(ui.Progress[1].PreviousStatus).To(Equal("stopping"))
+			Expect(ui.Progress[1].Status).To(Equal("stopped"))
+		})
+
+		It("displays the error if the instance cannot be stopped", func() {
+			fakeApi.StopInstanceReturns(errors.New("InvalidDBInstanceState"))
+			err := p.AwsRdsStopRun(conn, []string{"aws-rds-stop", "name"})
+			Expect(err).To(MatchError("InvalidDBInstanceState"))
+			Expect(ui.Err).To(Equal(err))
+		})
+	})
+
+	Describe("aws-rds-start", func() {
+		It("starts the service's instance and waits until it is available", func() {
+			p.Run(conn, []string{"aws-rds-start", "name"})
+			_, instanceName := fakeApi.StartInstanceArgsForCall(0)
+			Expect(instanceName).To(Equal("name"))
+			Expect(ui.TextTemplate).To(Equal("Successfully started RDS instance {{.ServiceName}}"))
+		})
+
+		It("displays the error if waiting fails", func() {
+			fakeApi.StartInstanceReturns(errors.New("timed out"))
+			err := p.AwsRdsStartRun(conn, []string{"aws-rds-start", "name"})
+			Expect(err).To(MatchError("timed out"))
+		})
+	})
+
+	Describe("aws-rds-reboot", func() {
+		It("reboots the service's instance", func() {
+			p.Run(conn, []string{"aws-rds-reboot", "name"})
+			_, instanceName, forceFailover := fakeApi.RebootInstanceArgsForCall(0)
+			Expect(instanceName).To(Equal("name"))
+			Expect(forceFailover).To(BeFalse())
+			Expect(fakeApi.DescribeInstanceCallCount()).To(Equal(0))
+			Expect(ui.TextTemplate).To(Equal("Successfully rebooted RDS instance {{.ServiceName}}"))
+		})
+
+		It("fails a Multi-AZ instance over with --force-failover", func() {
+			fakeApi.DescribeInstanceReturns(&api.DBInstance{MultiAZ: true}, nil)
+			p.Run(conn, []string{"aws-rds-reboot", "name", "--force-failover"})
+			_, _, forceFailover := fakeApi.RebootInstanceArgsForCall(0)
+			Expect(forceFailover).To(BeTrue())
+		})
+
+		It("rejects --force-failover for single-AZ instances", func() {
+			fakeApi.DescribeInstanceReturns(&api.DBInstance{MultiAZ: false}, nil)
+			err := p.AwsRdsRebootRun(conn, []string{"aws-rds-reboot", "name", "--force-failover"})
+			Expect(err).To(MatchError("Error: --force-failover can only be used with Multi-AZ instances"))
+			Expect(ExitCode(err)).To(Equal(ExitFailure))
+			Expect(fakeApi.RebootInstanceCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,209 @@
+package cf_rds
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"code.cloudfoundry.org/cli/plugin"
+	"code.cloudfoundry.org/cli/plugin/models"
+	"github.com/seattle-beach/cf-cli-rds-plugin/api"
+)
+
+type AwsRdsListOptions struct{}
+
+func (c *BasicPlugin) AwsRdsListRun(cliConnection plugin.CliConnection, args []string) error {
+	opts := AwsRdsListOptions{}
+	err := c.getOptionsWithoutArgs(&opts, args)
+	if err != nil {
+		return err
+	}
+
+	instances, err := c.Api.ListInstances()
+	if err != nil {
+		c.UI.DisplayError(err)
+		return err
+	}
+
+	services, err := cliConnection.GetServices()
+	err = cfCLIError(err)
+	if err != nil {
+		c.UI.DisplayError(err)
+		return err
+	}
+
+	space, err := cliConnection.GetCurrentSpace()
+	err = cfCLIError(err)
+	if err != nil {
+		c.UI.DisplayError(err)
+		return err
+	}
+
+	credentials, err := listUPSCredentials(cliConnection, space.Guid)
+	if err != nil {
+		c.UI.DisplayError(err)
+		return err
+	}
+
+	// Only the services the plugin registered, pointing at an RDS endpoint,
+	// and the instances it tagged or named after one of them are listed.
+	servicesByName := map[string]plugin_models.GetServices_Model{}
+	for _, service := range services {
+		if service.IsUserProvided && isRDSCredentials(credentials[service.Name]) {
+			servicesByName[service.Name] = service
+		}
+	}
+
+	entries := []ListEntryOutput{}
+	listedClusters := map[string]bool{}
+	for _, instance := range instances {
+		// The members of a DB cluster share its service, so the cluster is
+		// listed once under its own name.
+		name := instance.InstanceName
+		if instance.ClusterName != "" {
+			if listedClusters[instance.ClusterName] {
+				continue
+			}
+			listedClusters[instance.ClusterName] = true
+			name = instance.ClusterName
+		}
+		if !api.HasPluginTags(instance.Tags) && !isRDSCredentials(credentials[name]) {
+			continue
+		}
+
+		entry := ListEntryOutput{
+			Name:      name,
+			Engine:    instance.Engine,
+			Class:     instance.InstanceClass,
+			Status:    instance.Status,
+			Hostname:  instance.Address,
+			Port:      instance.Port,
+			BoundApps: []string{},
+		}
+
+		// Instances tagged for another space belong to a service there, and
+		// tagged instances may be named other than their service.
+		if spaceGUID, tagged := instance.Tags[api.SpaceGUIDTag]; tagged && spaceGUID != space.Guid {
+			entry.Space = instance.Tags[api.SpaceTag]
+			entries = append(entries, entry)
+			continue
+		}
+		serviceName := name
+		if tagName, tagged := instance.Tags[api.ServiceTag]; tagged {
+			serviceName = tagName
+		}
+
+		service, found := servicesByName[serviceName]
+		if found {
+			delete(servicesByName, serviceName)
+			entry.Space = space.Name
+			entry.BoundApps = append(entry.BoundApps, service.ApplicationNames...)
+		} else {
+			entry.Drift = "no service"
+		}
+		entries = append(entries, entry)
+	}
+
+	for _, service := range services {
+		if _, orphaned := servicesByName[service.Name]; !orphaned {
+			continue
+		}
+		entries = append(entries, ListEntryOutput{
+			Name:      service.Name,
+			Space:     space.Name,
+			BoundApps: append([]string{}, service.ApplicationNames...),
+			Drift:     "no RDS instance",
+		})
+	}
+
+	if c.machineOutput() {
+		return c.displayDocument("instances", entries)
+	}
+
+	table := [][]string{{"name", "engine", "class", "status", "endpoint", "space", "bound apps", "drift"}}
+	drifted := 0
+	for _, entry := range entries {
+		if entry.Drift != "" {
+			drifted++
+		}
+		address := ""
+		if entry.Hostname != "" {
+			address = fmt.Sprintf("%s:%d", entry.Hostname, entry.Port)
+		}
+		table = append(table, []string{entry.Name, entry.Engine, entry.Class, entry.Status, address, entry.Space, strings.Join(entry.BoundApps, ", "), entry.Drift})
+	}
+
+	if len(table) == 1 {
+		c.UI.DisplayText("No RDS instances or user-provided services found.")
+		return nil
+	}
+
+	c.UI.DisplayTableWithHeader("", table, 3)
+	if drifted > 0 {
+		c.UI.DisplayText("{{.Count}} RDS instances or user-provided services have no counterpart in space {{.Space}}.", map[string]interface{}{
+			"Count": drifted,
+			"Space": space.Name,
+		})
+	}
+	return nil
+}
+
+// listUPSCredentials returns the credentials of the user-provided services in
+// the space, keyed by service name, following next_url until all pages have
+// been read.
+func listUPSCredentials(cli plugin.CliConnection, spaceGUID string) (map[string]*api.DBInstance, error) {
+	credentials := map[string]*api.DBInstance{}
+	path := "/v2/user_provided_service_instances?q=space_guid:" + spaceGUID
+
+	for path != "" {
+		output, err := cli.CliCommandWithoutTerminalOutput("curl", path)
+		if err != nil {
+			return nil, cfCLIError(err)
+		}
+
+		resp := struct {
+			Description string `json:"description"`
+			NextURL     string `json:"next_url"`
+			Resources   []struct {
+				Entity struct {
+					Name        string         `json:"name"`
+					Credentials api.DBInstance `json:"credentials"`
+				} `json:"entity"`
+			} `json:"resources"`
+		}{}
+		err = json.Unmarshal([]byte(strings.Join(output, "\n")), &resp)
+		if err != nil {
+			return nil, err
+		}
+		if resp.Resources == nil {
+			return nil, cfCLIError(fmt.Errorf("Could not read credentials of user-provided services: %s", resp.Description))
+		}
+
+		for _, resource := range resp.Resources {
+			entity := resource.Entity
+			credentials[entity.Name] = &entity.Credentials
+		}
+		path = resp.NextURL
+	}
+
+	return credentials, nil
+}
+
+// isRDSCredentials reports whether the credentials point at an RDS endpoint,
+// as those of the services the plugin registers do.
+func isRDSCredentials(credentials *api.DBInstance) bool {
+	if credentials == nil {
+		return false
+	}
+
+	host := credentials.Address
+	if host == "" {
+		parsed, err := url.Parse(credentials.DBURI)
+		if err != nil {
+			return false
+		}
+		host = parsed.Hostname()
+	}
+	return strings.Contains(host, ".rds.amazonaws.com")
+}
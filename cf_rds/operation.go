@@ -0,0 +1,85 @@
+package cf_rds
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"github.com/seattle-beach/cf-cli-rds-plugin/api"
+)
+
+// operationContext returns the context long-running operations run in. It is
+// cancelled on Ctrl-C and once the --timeout has passed.
+func (c *BasicPlugin) operationContext() (context.Context, context.CancelFunc) {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	if c.Timeout > 0 {
+		ctx, cancel = context.WithTimeout(context.Background(), c.Timeout)
+	} else {
+		ctx, cancel = context.WithCancel(context.Background())
+	}
+
+	interrupts := make(chan os.Signal, 1)
+	signal.Notify(interrupts, os.Interrupt)
+	go func() {
+		select {
+		case <-interrupts:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	return ctx, func() {
+		signal.Stop(interrupts)
+		cancel()
+	}
+}
+
+// runOperation runs a long-running API operation, displaying its progress
+// until it finishes. If the operation was interrupted or timed out, the
+// returned error says so and tells the user how to carry on.
+func (c *BasicPlugin) runOperation(resumeHint string, operation func(ctx context.Context) error) error {
+	ctx, cancel := c.operationContext()
+	defer cancel()
+
+	progress := make(chan api.Progress)
+	ctx = api.WithProgress(ctx, func(p api.Progress) {
+		progress <- p
+	})
+
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- operation(ctx)
+	}()
+
+	err := c.waitForOperation(errChan, progress)
+	switch err {
+	case context.Canceled:
+		return &CommandError{ExitCode: ExitInterrupted, Err: fmt.Errorf("Interrupted. %s", resumeHint)}
+	case context.DeadlineExceeded:
+		return &CommandError{ExitCode: ExitTimeout, Err: fmt.Errorf("Timed out after %s. %s", c.Timeout, resumeHint)}
+	}
+	return err
+}
+
+// waitForOperation blocks until the operation reports its result on errChan,
+// displaying the progress it reports in the meantime.
+func (c *BasicPlugin) waitForOperation(errChan chan error, progress chan api.Progress) error {
+	for {
+		select {
+		case err := <-errChan:
+			return err
+		case p := <-progress:
+			c.UI.DisplayProgress(p)
+		}
+	}
+}
+
+func refreshHint(serviceName string) string {
+	return fmt.Sprintf("RDS keeps working on instance %s; run `cf aws-rds-refresh %s` to register it as a service once it is available.", serviceName, serviceName)
+}
+
+func infoHint(serviceName string) string {
+	return fmt.Sprintf("RDS keeps working on instance %s; check its status with `cf aws-rds-info %s`.", serviceName, serviceName)
+}
@@ -0,0 +1,286 @@
+package cf_rds
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/seattle-beach/cf-cli-rds-plugin/api"
+	"gopkg.in/yaml.v2"
+)
+
+// OutputSchemaVersion is the version of the documents written with
+// --output json or yaml. It changes whenever a field is removed or changes
+// its meaning; new fields may be added without changing it.
+const OutputSchemaVersion = 1
+
+// InstanceOutput describes an RDS instance in machine-readable output.
+type InstanceOutput struct {
+	Name                 string             `json:"name" yaml:"name"`
+	ARN                  string             `json:"arn,omitempty" yaml:"arn,omitempty"`
+	ResourceID           string             `json:"resource_id,omitempty" yaml:"resource_id,omitempty"`
+	Status               string             `json:"status,omitempty" yaml:"status,omitempty"`
+	Engine               string             `json:"engine,omitempty" yaml:"engine,omitempty"`
+	EngineVersion        string             `json:"engine_version,omitempty" yaml:"engine_version,omitempty"`
+	Class                string             `json:"class,omitempty" yaml:"class,omitempty"`
+	StorageGB            int64              `json:"storage_gb,omitempty" yaml:"storage_gb,omitempty"`
+	MultiAZ              bool               `json:"multi_az" yaml:"multi_az"`
+	PubliclyAccessible   bool               `json:"publicly_accessible" yaml:"publicly_accessible"`
+	AutoMinorUpgrade     bool               `json:"auto_minor_version_upgrade" yaml:"auto_minor_version_upgrade"`
+	BackupRetentionDays  int64              `json:"backup_retention_days,omitempty" yaml:"backup_retention_days,omitempty"`
+	MaintenanceWindow    string             `json:"maintenance_window,omitempty" yaml:"maintenance_window,omitempty"`
+	Hostname             string             `json:"hostname,omitempty" yaml:"hostname,omitempty"`
+	Port                 int64              `json:"port,omitempty" yaml:"port,omitempty"`
+	Cluster              string             `json:"cluster,omitempty" yaml:"cluster,omitempty"`
+	ReaderHostname       string             `json:"reader_hostname,omitempty" yaml:"reader_hostname,omitempty"`
+	ReplicaOf            string             `json:"replica_of,omitempty" yaml:"replica_of,omitempty"`
+	VPC                  string             `json:"vpc,omitempty" yaml:"vpc,omitempty"`
+	SubnetGroup          string             `json:"subnet_group,omitempty" yaml:"subnet_group,omitempty"`
+	ParameterGroup       string             `json:"parameter_group,omitempty" yaml:"parameter_group,omitempty"`
+	SecurityGroups       []string           `json:"security_groups" yaml:"security_groups"`
+	PendingModifications string             `json:"pending_modifications,omitempty" yaml:"pending_modifications,omitempty"`
+	Credentials          *CredentialsOutput `json:"credentials,omitempty" yaml:"credentials,omitempty"`
+}
+
+// CredentialsOutput holds the credentials of a service. The password is
+// redacted, also within the URIs, unless --show-credentials is given.
+type CredentialsOutput struct {
+	Username string `json:"username,omitempty" yaml:"username,omitempty"`
+	Password string `json:"password,omitempty" yaml:"password,omitempty"`
+	Database string `json:"database,omitempty" yaml:"database,omitempty"`
+	URI      string `json:"uri,omitempty" yaml:"uri,omitempty"`
+	JDBCURL  string `json:"jdbc_url,omitempty" yaml:"jdbc_url,omitempty"`
+	ReadURI  string `json:"read_uri,omitempty" yaml:"read_uri,omitempty"`
+	Region   string `json:"region,omitempty" yaml:"region,omitempty"`
+	RoleARN  string `json:"role_arn,omitempty" yaml:"role_arn,omitempty"`
+}
+
+// ListEntryOutput is one row of aws-rds-list.
+type ListEntryOutput struct {
+	Name      string   `json:"name" yaml:"name"`
+	Engine    string   `json:"engine,omitempty" yaml:"engine,omitempty"`
+	Class     string   `json:"class,omitempty" yaml:"class,omitempty"`
+	Status    string   `json:"status,omitempty" yaml:"status,omitempty"`
+	Hostname  string   `json:"hostname,omitempty" yaml:"hostname,omitempty"`
+	Port      int64    `json:"port,omitempty" yaml:"port,omitempty"`
+	Space     string   `json:"space,omitempty" yaml:"space,omitempty"`
+	BoundApps []string `json:"bound_apps" yaml:"bound_apps"`
+	// Drift is "no service" or "no RDS instance" when either side is
+	// missing, and empty otherwise.
+	Drift string `json:"drift,omitempty" yaml:"drift,omitempty"`
+}
+
+// SnapshotOutput describes a DB snapshot in machine-readable output.
+type SnapshotOutput struct {
+	Name      string `json:"name" yaml:"name"`
+	Instance  string `json:"instance,omitempty" yaml:"instance,omitempty"`
+	Type      string `json:"type,omitempty" yaml:"type,omitempty"`
+	Status    string `json:"status,omitempty" yaml:"status,omitempty"`
+	Created   string `json:"created,omitempty" yaml:"created,omitempty"`
+	Engine    string `json:"engine,omitempty" yaml:"engine,omitempty"`
+	StorageGB int64  `json:"storage_gb,omitempty" yaml:"storage_gb,omitempty"`
+}
+
+// SubnetGroupOutput describes a DB subnet group in machine-readable output.
+type SubnetGroupOutput struct {
+	Name              string         `json:"name" yaml:"name"`
+	Description       string         `json:"description,omitempty" yaml:"description,omitempty"`
+	VPC               string         `json:"vpc,omitempty" yaml:"vpc,omitempty"`
+	Status            string         `json:"status,omitempty" yaml:"status,omitempty"`
+	AvailabilityZones []string       `json:"availability_zones" yaml:"availability_zones"`
+	ActiveSubnets     int            `json:"active_subnets" yaml:"active_subnets"`
+	Subnets           []SubnetOutput `json:"subnets" yaml:"subnets"`
+}
+
+// SubnetOutput is a subnet of a DB subnet group.
+type SubnetOutput struct {
+	ID               string `json:"id" yaml:"id"`
+	AvailabilityZone string `json:"availability_zone,omitempty" yaml:"availability_zone,omitempty"`
+	Status           string `json:"status,omitempty" yaml:"status,omitempty"`
+}
+
+// ServiceOutput describes a user-provided service registered by the plugin.
+type ServiceOutput struct {
+	Name  string `json:"name" yaml:"name"`
+	Space string `json:"space,omitempty" yaml:"space,omitempty"`
+	URI   string `json:"uri,omitempty" yaml:"uri,omitempty"`
+}
+
+// TokenOutput is an IAM auth token along with what it logs in to.
+type TokenOutput struct {
+	Hostname string `json:"hostname" yaml:"hostname"`
+	Port     int64  `json:"port" yaml:"port"`
+	Username string `json:"username" yaml:"username"`
+	Region   string `json:"region" yaml:"region"`
+	Token    string `json:"token" yaml:"token"`
+}
+
+// ErrorOutput reports a failed command.
+type ErrorOutput struct {
+	Message string `json:"message" yaml:"message"`
+}
+
+func newInstanceOutput(instance *api.DBInstance) *InstanceOutput {
+	output := &InstanceOutput{
+		Name:                 instance.InstanceName,
+		ARN:                  instance.ARN,
+		ResourceID:           instance.ResourceID,
+		Status:               instance.Status,
+		Engine:               instance.Engine,
+		EngineVersion:        instance.EngineVersion,
+		Class:                instance.InstanceClass,
+		StorageGB:            instance.Storage,
+		MultiAZ:              instance.MultiAZ,
+		PubliclyAccessible:   instance.PubliclyAccessible,
+		AutoMinorUpgrade:     instance.AutoMinorVersionUpgrade,
+		BackupRetentionDays:  instance.BackupRetention,
+		MaintenanceWindow:    instance.MaintenanceWindow,
+		Hostname:             instance.Address,
+		Port:                 instance.Port,
+		Cluster:              instance.ClusterName,
+		ReaderHostname:       instance.ReaderAddress,
+		ReplicaOf:            instance.ReplicaSource,
+		SecurityGroups:       []string{},
+		PendingModifications: pendingModifications(instance.PendingModifications),
+	}
+	if instance.ParameterGroup != nil {
+		output.ParameterGroup = aws.StringValue(instance.ParameterGroup.DBParameterGroupName)
+	}
+	if instance.SubnetGroup != nil {
+		output.VPC = aws.StringValue(instance.SubnetGroup.VpcId)
+		output.SubnetGroup = aws.StringValue(instance.SubnetGroup.DBSubnetGroupName)
+	}
+	for _, secGroup := range instance.SecGroups {
+		output.SecurityGroups = append(output.SecurityGroups, aws.StringValue(secGroup.VpcSecurityGroupId))
+	}
+	return output
+}
+
+func newCredentialsOutput(credentials *api.DBInstance, showCredentials bool) *CredentialsOutput {
+	output := &CredentialsOutput{
+		Username: credentials.Username,
+		Password: credentials.Password,
+		Database: credentials.DBName,
+		URI:      credentials.DBURI,
+		JDBCURL:  credentials.JDBCURI,
+		ReadURI:  credentials.ReadURI,
+		Region:   credentials.Region,
+		RoleARN:  credentials.RoleARN,
+	}
+	if !showCredentials && credentials.Password != "" {
+		output.Password = maskedValue
+		output.URI = maskPassword(output.URI, credentials.Password)
+		output.JDBCURL = maskPassword(output.JDBCURL, credentials.Password)
+		output.ReadURI = maskPassword(output.ReadURI, credentials.Password)
+	}
+	return output
+}
+
+func newSnapshotOutput(snapshot *api.DBSnapshot) SnapshotOutput {
+	output := SnapshotOutput{
+		Name:      snapshot.Name,
+		Instance:  snapshot.InstanceName,
+		Type:      snapshot.Type,
+		Status:    snapshot.Status,
+		Engine:    snapshot.Engine,
+		StorageGB: snapshot.Storage,
+	}
+	if !snapshot.Created.IsZero() {
+		output.Created = snapshot.Created.Format(time.RFC3339)
+	}
+	return output
+}
+
+func newSubnetGroupOutput(subnetGroup *api.SubnetGroup) SubnetGroupOutput {
+	output := SubnetGroupOutput{
+		Name:              aws.StringValue(subnetGroup.DBSubnetGroupName),
+		Description:       aws.StringValue(subnetGroup.DBSubnetGroupDescription),
+		VPC:               aws.StringValue(subnetGroup.VpcId),
+		Status:            aws.StringValue(subnetGroup.SubnetGroupStatus),
+		AvailabilityZones: subnetGroup.AvailabilityZones,
+		ActiveSubnets:     subnetGroup.ActiveSubnets,
+		Subnets:           []SubnetOutput{},
+	}
+	for _, subnet := range subnetGroup.Subnets {
+		subnetOutput := SubnetOutput{
+			ID:     aws.StringValue(subnet.SubnetIdentifier),
+			Status: aws.StringValue(subnet.SubnetStatus),
+		}
+		if subnet.SubnetAvailabilityZone != nil {
+			subnetOutput.AvailabilityZone = aws.StringValue(subnet.SubnetAvailabilityZone.Name)
+		}
+		output.Subnets = append(output.Subnets, subnetOutput)
+	}
+	return output
+}
+
+// maskPassword replaces the password within a connection URI or JDBC URL.
+// Only whole values are replaced, i.e. the password, escaped as it appears in
+// URIs or not, between the delimiters URIs and JDBC URLs put around it.
+func maskPassword(s string, password string) string {
+	for _, escaped := range []string{password, url.QueryEscape(password), url.PathEscape(password)} {
+		value := regexp.MustCompile("([:=/{])" + regexp.QuoteMeta(escaped) + "([@&;}]|$)")
+		s = value.ReplaceAllString(s, "${1}"+maskedValue+"${2}")
+	}
+	return s
+}
+
+// machineOutput reports whether the user asked for json or yaml output.
+func (c *BasicPlugin) machineOutput() bool {
+	return c.Output == "json" || c.Output == "yaml"
+}
+
+// displayDocument writes a versioned document holding value under key in the
+// requested output format.
+func (c *BasicPlugin) displayDocument(key string, value interface{}) error {
+	document := map[string]interface{}{
+		"schema_version": OutputSchemaVersion,
+		key:              value,
+	}
+
+	var out []byte
+	var err error
+	switch c.Output {
+	case "yaml":
+		out, err = yaml.Marshal(document)
+	default:
+		out, err = json.MarshalIndent(document, "", "  ")
+		out = append(out, '\n')
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprint(c.UI.Writer(), string(out))
+	return err
+}
+
+// machineUI keeps the text meant for humans out of json and yaml output.
+// Errors are reported by Run as a document of their own.
+type machineUI struct {
+	TinyUI
+}
+
+func (u *machineUI) DisplayError(err error) {}
+
+func (u *machineUI) DisplayText(template string, data ...map[string]interface{}) {}
+
+func (u *machineUI) DisplayKeyValueTable(prefix string, table [][]string, padding int) {}
+
+func (u *machineUI) DisplayTableWithHeader(prefix string, table [][]string, padding int) {}
+
+func (u *machineUI) DisplayProgress(progress api.Progress) {}
+
+// Prompts would end up in the document, so commands that would ask one fail
+// and point to the option that answers it instead.
+func (u *machineUI) DisplayBoolPrompt(defaultResponse bool, template string, templateValues ...map[string]interface{}) (bool, error) {
+	return false, usageError(errors.New("Incorrect Usage: cannot ask for confirmation with --output json or yaml; pass -f to go ahead"))
+}
+
+func (u *machineUI) DisplayChoicePrompt(template string, choices []string, templateValues ...map[string]interface{}) (int, error) {
+	return -1, usageError(errors.New("Incorrect Usage: cannot ask which DB subnet group to use with --output json or yaml; pass --subnet-group NAME"))
+}
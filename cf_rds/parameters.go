@@ -0,0 +1,91 @@
+package cf_rds
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/plugin"
+	"gopkg.in/yaml.v2"
+)
+
+type AwsRdsSetParametersOptions struct{}
+
+func (c *BasicPlugin) AwsRdsSetParametersRun(cliConnection plugin.CliConnection, args []string) error {
+	opts := AwsRdsSetParametersOptions{}
+	names, err := c.getOptionsWithArgs(&opts, args, 2)
+	if err != nil {
+		return err
+	}
+	serviceName, path := names[0], names[1]
+
+	parameters, err := readParameters(path)
+	if err != nil {
+		c.UI.DisplayError(err)
+		return err
+	}
+
+	instanceName, err := c.instanceName(cliConnection, serviceName)
+	if err != nil {
+		c.UI.DisplayError(err)
+		return err
+	}
+	pendingReboot, err := c.Api.SetParameters(instanceName, parameters)
+	if err != nil {
+		c.UI.DisplayError(err)
+		return err
+	}
+
+	c.displayParameters(serviceName, parameters, pendingReboot)
+	return nil
+}
+
+// readParameters reads a YAML or JSON map of DB parameters to their values.
+func readParameters(path string) (map[string]string, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, usageError(fmt.Errorf("Incorrect Usage: cannot read the parameters: %v", err))
+	}
+
+	var values map[string]interface{}
+	err = yaml.Unmarshal(contents, &values)
+	if err != nil {
+		return nil, usageError(fmt.Errorf("Incorrect Usage: %s must hold a YAML or JSON map of parameters to their values: %v", path, err))
+	}
+	if len(values) == 0 {
+		return nil, usageError(fmt.Errorf("Incorrect Usage: %s does not set any parameters", path))
+	}
+
+	parameters := map[string]string{}
+	for name, value := range values {
+		switch value := value.(type) {
+		case string:
+			parameters[name] = value
+		case int:
+			parameters[name] = strconv.Itoa(value)
+		case float64:
+			parameters[name] = strconv.FormatFloat(value, 'f', -1, 64)
+		case bool:
+			parameters[name] = strconv.FormatBool(value)
+		default:
+			return nil, usageError(fmt.Errorf("Incorrect Usage: the value of parameter %s must be a string, number or boolean", name))
+		}
+	}
+	return parameters, nil
+}
+
+// displayParameters reports the parameters set on the instance and those that
+// wait for it to reboot.
+func (c *BasicPlugin) displayParameters(serviceName string, parameters map[string]string, pendingReboot []string) {
+	c.UI.DisplayText("Set {{.Count}} parameters of RDS instance {{.ServiceName}}", map[string]interface{}{
+		"Count":       len(parameters),
+		"ServiceName": serviceName,
+	})
+	if len(pendingReboot) > 0 {
+		c.UI.DisplayText("These parameters take effect once the instance reboots: {{.Parameters}}. Run `cf aws-rds-reboot {{.ServiceName}}` to apply them now.", map[string]interface{}{
+			"Parameters":  strings.Join(pendingReboot, ", "),
+			"ServiceName": serviceName,
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package cf_rds_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/plugin/pluginfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/seattle-beach/cf-cli-rds-plugin/cf_rds"
+	"github.com/seattle-beach/cf-cli-rds-plugin/cf_rds/fakes"
+)
+
+// writeParameters writes a parameters file into dir.
+func writeParameters(dir string, contents string) string {
+	path := filepath.Join(dir, "parameters.yml")
+	err := ioutil.WriteFile(path, []byte(contents), 0600)
+	Expect(err).NotTo(HaveOccurred())
+	return path
+}
+
+var _ = Describe("set-parameters", func() {
+	var ui MockUi
+	var conn *pluginfakes.FakeCliConnection
+	var fakeApi *fakes.FakeApi
+	var p *BasicPlugin
+	var args []string
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "parameters")
+		Expect(err).NotTo(HaveOccurred())
+
+		conn = &pluginfakes.FakeCliConnection{}
+		ui = MockUi{}
+		fakeApi = &fakes.FakeApi{}
+
+		p = &BasicPlugin{
+			UI:  &ui,
+			Api: fakeApi,
+		}
+		args = []string{"aws-rds-set-parameters", "name"}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It("sets the parameters of a YAML file", func() {
+		path := writeParameters(dir, "log_min_duration_statement: 500\nrds.force_ssl: true\nsearch_path: '\"$user\", public'\n")
+		p.Run(conn, append(args, path))
+
+		Expect(fakeApi.SetParametersCallCount()).To(Equal(1))
+		instanceName, parameters := fakeApi.SetParametersArgsForCall(0)
+		Expect(instanceName).To(Equal("name"))
+		Expect(parameters).To(Equal(map[string]string{
+			"log_min_duration_statement": "500",
+			"rds.force_ssl":              "true",
+			"search_path":                `"$user", public`,
+		}))
+	})
+
+	It("sets the parameters of a JSON file", func() {
+		path := writeParameters(dir, `{"max_connections": "200", "work_mem": 8192}`)
+		p.Run(conn, append(args, path))
+
+		_, parameters := fakeApi.SetParametersArgsForCall(0)
+		Expect(parameters).To(Equal(map[string]string{
+			"max_connections": "200",
+			"work_mem":        "8192",
+		}))
+	})
+
+	It("writes large and fractional numbers in full", func() {
+		path := writeParameters(dir, "checkpoint_completion_target: 0.9\nmax_wal_size: 1000000.0\nwork_mem: 1e6\n")
+		p.Run(conn, append(args, path))
+
+		_, parameters := fakeApi.SetParametersArgsForCall(0)
+		Expect(parameters).To(Equal(map[string]string{
+			"checkpoint_completion_target": "0.9",
+			"max_wal_size":                 "1000000",
+			"work_mem":                     "1000000",
+		}))
+	})
+
+	It("reports the parameters that take effect once the instance reboots", func() {
+		fakeApi.SetParametersReturns([]string{"max_connections", "shared_buffers"}, nil)
+		p.Run(conn, append(args, writeParameters(dir, "max_connections: 200\nshared_buffers: 16384\nwork_mem: 8192\n")))
+
+		Expect(ui.Texts[0]).To(Equal("Set {{.Count}} parameters of RDS instance {{.ServiceName}}"))
+		Expect(ui.TextData[0]["Count"]).To(Equal(3))
+		Expect(ui.TextTemplate).To(Equal("These parameters take effect once the instance reboots: {{.Parameters}}. Run `cf aws-rds-reboot {{.ServiceName}}` to apply them now."))
+		Expect(ui.Data["Parameters"]).To(Equal("max_connections, shared_buffers"))
+	})
+
+	It("does not mention a reboot if every parameter is applied immediately", func() {
+		p.Run(conn, append(args, writeParameters(dir, "work_mem: 8192\n")))
+		Expect(ui.Texts).To(HaveLen(1))
+	})
+
+	Context("error cases", func() {
+		var exitCode int
+
+		BeforeEach(func() {
+			exitCode = 0
+			p.Exit = func(code int) {
+				exitCode = code
+			}
+		})
+
+		It("displays usage without a file", func() {
+			p.Run(conn, args)
+			Expect(ui.Err).To(MatchError("Incorrect Usage: not enough arguments passed"))
+			Expect(ui.Data["Usage"]).To(Equal("cf aws-rds-set-parameters SERVICE_NAME FILE"))
+		})
+
+		It("rejects a file that cannot be read", func() {
+			p.Run(conn, append(args, "/nonexistent/parameters.yml"))
+			Expect(ui.Err.Error()).To(HavePrefix("Incorrect Usage: cannot read the parameters:"))
+			Expect(exitCode).To(Equal(ExitUsage))
+			Expect(fakeApi.SetParametersCallCount()).To(Equal(0))
+		})
+
+		It("rejects a file that is not a map", func() {
+			path := writeParameters(dir, "- max_connections\n")
+			p.Run(conn, append(args, path))
+			Expect(ui.Err.Error()).To(HavePrefix("Incorrect Usage: " + path + " must hold a YAML or JSON map of parameters to their values"))
+			Expect(exitCode).To(Equal(ExitUsage))
+		})
+
+		It("rejects a file without parameters", func() {
+			path := writeParameters(dir, "")
+			p.Run(conn, append(args, path))
+			Expect(ui.Err).To(MatchError("Incorrect Usage: " + path + " does not set any parameters"))
+		})
+
+		It("rejects values that are not scalars", func() {
+			p.Run(conn, append(args, writeParameters(dir, "shared_preload_libraries: [pg_stat_statements]\n")))
+			Expect(ui.Err).To(MatchError("Incorrect Usage: the value of parameter shared_preload_libraries must be a string, number or boolean"))
+			Expect(fakeApi.SetParametersCallCount()).To(Equal(0))
+		})
+
+		It("displays the error if the parameters cannot be set", func() {
+			fakeApi.SetParametersReturns(nil, errors.New("Error: max_conns is not a parameter of postgres that can be modified"))
+			err := p.AwsRdsSetParametersRun(conn, append(args, writeParameters(dir, "max_conns: 200\n")))
+			Expect(err).To(MatchError("Error: max_conns is not a parameter of postgres that can be modified"))
+			Expect(ui.Err).To(Equal(err))
+		})
+	})
+})
@@ -0,0 +1,94 @@
+package cf_rds
+
+import (
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/cli/util/ui"
+	"github.com/seattle-beach/cf-cli-rds-plugin/api"
+	"github.com/vito/go-interact/interact"
+)
+
+// ProgressUI adds progress rendering to the CLI's UI. On a TTY the progress
+// of an operation is a status line that is rewritten in place; otherwise
+// every new status is logged on a line of its own.
+type ProgressUI struct {
+	*ui.UI
+
+	last       api.Progress
+	statusLine bool
+}
+
+func (u *ProgressUI) DisplayProgress(progress api.Progress) {
+	template := "{{.Resource}} {{.Name}} is {{.Status}} ({{.Elapsed}} elapsed)"
+	if progress.Percent >= 0 {
+		template = "{{.Resource}} {{.Name}} is {{.Status}}, {{.Percent}}% done ({{.Elapsed}} elapsed)"
+	}
+	data := map[string]interface{}{
+		"Resource": progress.Resource,
+		"Name":     progress.Name,
+		"Status":   progress.Status,
+		"Percent":  progress.Percent,
+		"Elapsed":  progress.Elapsed.Round(time.Second),
+	}
+
+	if u.IsTTY {
+		fmt.Fprintf(u.Out, "\r\033[K%s", u.TranslateText(template, data))
+		u.statusLine = true
+	} else if progress.StatusChanged() || progress.Percent != u.last.Percent {
+		u.UI.DisplayText(template, data)
+	}
+	u.last = progress
+}
+
+func (u *ProgressUI) DisplayError(err error) {
+	u.endStatusLine()
+	u.UI.DisplayError(err)
+}
+
+func (u *ProgressUI) DisplayText(template string, data ...map[string]interface{}) {
+	u.endStatusLine()
+	u.UI.DisplayText(template, data...)
+}
+
+func (u *ProgressUI) DisplayKeyValueTable(prefix string, table [][]string, padding int) {
+	u.endStatusLine()
+	u.UI.DisplayKeyValueTable(prefix, table, padding)
+}
+
+func (u *ProgressUI) DisplayBoolPrompt(defaultResponse bool, template string, templateValues ...map[string]interface{}) (bool, error) {
+	u.endStatusLine()
+	return u.UI.DisplayBoolPrompt(defaultResponse, template, templateValues...)
+}
+
+// DisplayChoicePrompt asks the user to pick one of choices and returns the
+// index of the picked one.
+func (u *ProgressUI) DisplayChoicePrompt(template string, choices []string, templateValues ...map[string]interface{}) (int, error) {
+	u.endStatusLine()
+
+	interactChoices := []interact.Choice{}
+	for i, choice := range choices {
+		interactChoices = append(interactChoices, interact.Choice{Display: choice, Value: i})
+	}
+
+	index := 0
+	prompt := interact.NewInteraction(u.TranslateText(template, templateValues...), interactChoices...)
+	prompt.Input = u.In
+	prompt.Output = u.Out
+	err := prompt.Resolve(&index)
+	return index, err
+}
+
+func (u *ProgressUI) DisplayTableWithHeader(prefix string, table [][]string, padding int) {
+	u.endStatusLine()
+	u.UI.DisplayTableWithHeader(prefix, table, padding)
+}
+
+// endStatusLine moves past the status line, so that whatever is displayed
+// next does not end up on it.
+func (u *ProgressUI) endStatusLine() {
+	if u.statusLine {
+		fmt.Fprintln(u.Out)
+		u.statusLine = false
+	}
+}
@@ -0,0 +1,65 @@
+package cf_rds_test
+
+import (
+	"bytes"
+	"time"
+
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/seattle-beach/cf-cli-rds-plugin/api"
+	. "github.com/seattle-beach/cf-cli-rds-plugin/cf_rds"
+)
+
+var _ = Describe("ProgressUI", func() {
+	var out *bytes.Buffer
+	var progressUI *ProgressUI
+
+	progress := func(status string, previousStatus string, percent int64) api.Progress {
+		return api.Progress{
+			Resource:       "DB snapshot",
+			Name:           "before-upgrade",
+			Status:         status,
+			PreviousStatus: previousStatus,
+			Percent:        percent,
+			Elapsed:        90*time.Second + 300*time.Millisecond,
+		}
+	}
+
+	BeforeEach(func() {
+		out = &bytes.Buffer{}
+		progressUI = &ProgressUI{UI: ui.NewTestUI(nil, out, &bytes.Buffer{})}
+	})
+
+	It("logs a line whenever the status or the percent progress changes", func() {
+		progressUI.DisplayProgress(progress("creating", "", -1))
+		progressUI.DisplayProgress(progress("creating", "creating", -1))
+		progressUI.DisplayProgress(progress("creating", "creating", 40))
+		progressUI.DisplayProgress(progress("available", "creating", 100))
+
+		Expect(out.String()).To(Equal("DB snapshot before-upgrade is creating (1m30s elapsed)\n" +
+			"DB snapshot before-upgrade is creating, 40% done (1m30s elapsed)\n" +
+			"DB snapshot before-upgrade is available, 100% done (1m30s elapsed)\n"))
+	})
+
+	Context("on a TTY", func() {
+		BeforeEach(func() {
+			progressUI.IsTTY = true
+		})
+
+		It("rewrites a single status line", func() {
+			progressUI.DisplayProgress(progress("creating", "", -1))
+			progressUI.DisplayProgress(progress("creating", "creating", -1))
+
+			Expect(out.String()).To(Equal("\r\033[KDB snapshot before-upgrade is creating (1m30s elapsed)" +
+				"\r\033[KDB snapshot before-upgrade is creating (1m30s elapsed)"))
+		})
+
+		It("ends the status line before displaying anything else", func() {
+			progressUI.DisplayProgress(progress("available", "creating", -1))
+			progressUI.DisplayText("Done")
+
+			Expect(out.String()).To(HaveSuffix("(1m30s elapsed)\nDone\n"))
+		})
+	})
+})
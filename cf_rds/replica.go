@@ -0,0 +1,127 @@
+package cf_rds
+
+import (
+	"context"
+	"fmt"
+
+	"code.cloudfoundry.org/cli/plugin"
+	"github.com/seattle-beach/cf-cli-rds-plugin/api"
+)
+
+type AwsRdsCreateReplicaOptions struct {
+	Class           string   `long:"class" description:"The RDS instance type class of the replica. Defaults to the class of the source." required:"false"`
+	SubnetGroup     string   `long:"subnet-group" value-name:"NAME" description:"The DB subnet group of a replica in another region than its source." required:"false"`
+	Tags            []string `long:"tag" value-name:"KEY=VALUE" description:"A tag to set on the replica, next to those tracing it back to CF; can be given more than once." required:"false"`
+	ShowCredentials bool     `long:"show-credentials" description:"Show the credentials in json and yaml output instead of redacting them." required:"false"`
+}
+
+func (c *BasicPlugin) AwsRdsCreateReplicaRun(cliConnection plugin.CliConnection, args []string) error {
+	opts := AwsRdsCreateReplicaOptions{}
+	names, err := c.getOptionsWithArgs(&opts, args, 2)
+	if err != nil {
+		return err
+	}
+	sourceService, serviceName := names[0], names[1]
+
+	tags, err := c.resourceTags(cliConnection, serviceName, opts.Tags)
+	if err != nil {
+		c.UI.DisplayError(err)
+		return err
+	}
+
+	source, err := c.getUPSCredentials(sourceService, cliConnection)
+	if err != nil {
+		c.UI.DisplayError(err)
+		return err
+	}
+	if source.RoleARN != "" {
+		err = fmt.Errorf("Error: service %s logs in with IAM auth tokens and holds no credentials for a replica to share", sourceService)
+		c.UI.DisplayError(err)
+		return err
+	}
+	if source.ClusterName != "" {
+		err = fmt.Errorf("Error: service %s is an Aurora DB cluster; its reader endpoint is in the read_uri of the service", sourceService)
+		c.UI.DisplayError(err)
+		return err
+	}
+
+	// An ARN identifies the source in other regions as well.
+	sourceID := source.ARN
+	if sourceID == "" {
+		sourceID = source.InstanceName
+	}
+	if sourceID == "" {
+		sourceID = sourceService
+	}
+
+	replica := &api.DBInstance{
+		InstanceName:  serviceName,
+		InstanceClass: opts.Class,
+		Username:      source.Username,
+		Password:      source.Password,
+		DBName:        source.DBName,
+		Tags:          tags,
+	}
+	if opts.SubnetGroup != "" {
+		subnetGroup, err := c.chooseSubnetGroup(opts.SubnetGroup, "")
+		if err != nil {
+			c.UI.DisplayError(err)
+			return err
+		}
+		replica.SubnetGroup = subnetGroup.DBSubnetGroup
+	}
+
+	c.UI.DisplayText("Creating read replica {{.ServiceName}} of {{.SourceService}}. This may take several minutes...", map[string]interface{}{
+		"ServiceName":   serviceName,
+		"SourceService": sourceService,
+	})
+	return c.waitForApiResponse(serviceName, replica, cliConnection, opts.ShowCredentials, func(ctx context.Context) error {
+		return c.Api.CreateReplica(ctx, replica, sourceID)
+	})
+}
+
+type AwsRdsPromoteReplicaOptions struct {
+	ServiceName     string
+	ShowCredentials bool `long:"show-credentials" description:"Show the credentials in json and yaml output instead of redacting them." required:"false"`
+}
+
+func (a *AwsRdsPromoteReplicaOptions) SetServiceName(name string) {
+	a.ServiceName = name
+}
+
+func (c *BasicPlugin) AwsRdsPromoteReplicaRun(cliConnection plugin.CliConnection, args []string) error {
+	opts := AwsRdsPromoteReplicaOptions{}
+	err := c.getOptions(&opts, args)
+	if err != nil {
+		return err
+	}
+
+	instanceName, err := c.instanceName(cliConnection, opts.ServiceName)
+	if err != nil {
+		c.UI.DisplayError(err)
+		return err
+	}
+	instance := &api.DBInstance{
+		InstanceName: instanceName,
+	}
+
+	c.UI.DisplayText("Promoting read replica {{.ServiceName}} to a standalone RDS instance. This may take several minutes...", map[string]interface{}{
+		"ServiceName": opts.ServiceName,
+	})
+	resumeHint := fmt.Sprintf("RDS keeps promoting instance %s; run `cf aws-rds-rotate-credentials %s` once it is available to give the service credentials of its own.", instanceName, opts.ServiceName)
+	err = c.runOperation(resumeHint, func(ctx context.Context) error {
+		return c.Api.PromoteReplica(ctx, instance)
+	})
+	if err != nil {
+		c.UI.DisplayError(err)
+		return err
+	}
+
+	err = c.updateUPS(opts.ServiceName, instance, cliConnection)
+	if err != nil {
+		c.UI.DisplayError(err)
+		return err
+	}
+
+	return c.displayInstance(instance, opts.ShowCredentials)
+}
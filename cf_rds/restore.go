@@ -0,0 +1,122 @@
+package cf_rds
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/errors"
+	"code.cloudfoundry.org/cli/plugin"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/seattle-beach/cf-cli-rds-plugin/api"
+)
+
+type AwsRdsRestoreOptions struct {
+	ServiceName     string
+	FromSnapshot    string   `long:"from-snapshot" value-name:"SNAPSHOT" description:"The DB snapshot to restore the new RDS instance from. Either this or --from-service must be given." required:"false"`
+	FromService     string   `long:"from-service" value-name:"SERVICE_NAME" description:"The service whose RDS instance to restore to a point in time." required:"false"`
+	ToTime          string   `long:"to-time" value-name:"TIME" description:"The point in time to restore to, e.g. 2017-10-01T12:00:00Z. Defaults to the latest restorable time." required:"false"`
+	SubnetGroup     string   `long:"subnet-group" value-name:"NAME" description:"The DB subnet group to create the new RDS instance in. Defaults to that of the source instance." required:"false"`
+	Private         bool     `long:"private" description:"Do not give the new RDS instance a public endpoint. Defaults to whether the source instance has one." required:"false"`
+	Tags            []string `long:"tag" value-name:"KEY=VALUE" description:"A tag to set on the new RDS instance, next to those tracing it back to CF; can be given more than once." required:"false"`
+	ShowCredentials bool     `long:"show-credentials" description:"Show the credentials in json and yaml output instead of redacting them." required:"false"`
+}
+
+func (a *AwsRdsRestoreOptions) SetServiceName(name string) {
+	a.ServiceName = name
+}
+
+func (c *BasicPlugin) AwsRdsRestoreRun(cliConnection plugin.CliConnection, args []string) error {
+	opts := AwsRdsRestoreOptions{}
+	err := c.getOptions(&opts, args)
+	if err != nil {
+		return err
+	}
+
+	if (opts.FromSnapshot == "") == (opts.FromService == "") {
+		err = usageError(errors.New("Incorrect Usage: exactly one of --from-snapshot SNAPSHOT or --from-service SERVICE_NAME must be given"))
+		c.UI.DisplayError(err)
+		return err
+	}
+
+	var restoreTime time.Time
+	if opts.ToTime != "" {
+		if opts.FromService == "" {
+			err = usageError(errors.New("Incorrect Usage: --to-time can only be used with --from-service"))
+			c.UI.DisplayError(err)
+			return err
+		}
+
+		restoreTime, err = time.Parse(time.RFC3339, opts.ToTime)
+		if err != nil {
+			err = usageError(errors.New("Incorrect Usage: --to-time must be an RFC 3339 time such as 2017-10-01T12:00:00Z"))
+			c.UI.DisplayError(err)
+			return err
+		}
+	}
+
+	tags, err := c.resourceTags(cliConnection, opts.ServiceName, opts.Tags)
+	if err != nil {
+		c.UI.DisplayError(err)
+		return err
+	}
+
+	sourceInstance := ""
+	var source *api.DBInstance
+	vpc := ""
+	if opts.FromService != "" {
+		sourceInstance, err = c.instanceName(cliConnection, opts.FromService)
+		if err == nil {
+			source, err = c.Api.DescribeInstance(sourceInstance)
+		}
+	} else {
+		var snapshot *api.DBSnapshot
+		snapshot, source, err = c.Api.DescribeSnapshot(opts.FromSnapshot)
+		if err == nil {
+			vpc = snapshot.VPC
+		}
+	}
+	if err == nil && source != nil && source.ClusterName != "" {
+		err = fmt.Errorf("Error: RDS instance %s belongs to DB cluster %s, which cannot be restored", source.InstanceName, source.ClusterName)
+	}
+	if err != nil {
+		c.UI.DisplayError(err)
+		return err
+	}
+
+	dbInstance := &api.DBInstance{
+		InstanceName:       opts.ServiceName,
+		PubliclyAccessible: !opts.Private,
+		Tags:               tags,
+	}
+	if source != nil && !opts.Private {
+		dbInstance.PubliclyAccessible = source.PubliclyAccessible
+	}
+
+	// The new instance goes where the source instance is, and keeps its
+	// security groups unless it is moved to another VPC.
+	if opts.SubnetGroup == "" && source != nil && source.SubnetGroup != nil {
+		dbInstance.SubnetGroup = source.SubnetGroup
+	} else {
+		if opts.SubnetGroup != "" {
+			vpc = ""
+		}
+		subnetGroup, err := c.chooseSubnetGroup(opts.SubnetGroup, vpc)
+		if err != nil {
+			c.UI.DisplayError(err)
+			return err
+		}
+		dbInstance.SubnetGroup = subnetGroup.DBSubnetGroup
+	}
+	if source != nil && source.SubnetGroup != nil && aws.StringValue(source.SubnetGroup.VpcId) == aws.StringValue(dbInstance.SubnetGroup.VpcId) {
+		dbInstance.SecGroups = source.SecGroups
+	}
+
+	c.UI.DisplayText("Restoring RDS Instance. This may take several minutes...")
+	return c.waitForApiResponse(opts.ServiceName, dbInstance, cliConnection, opts.ShowCredentials, func(ctx context.Context) error {
+		if opts.FromSnapshot != "" {
+			return c.Api.RestoreFromSnapshot(ctx, dbInstance, opts.FromSnapshot)
+		}
+		return c.Api.RestoreToPointInTime(ctx, dbInstance, sourceInstance, restoreTime)
+	})
+}
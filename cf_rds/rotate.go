@@ -0,0 +1,138 @@
+package cf_rds
+
+import (
+	"context"
+	"fmt"
+
+	"code.cloudfoundry.org/cli/plugin"
+	"github.com/seattle-beach/cf-cli-rds-plugin/api"
+)
+
+type AwsRdsRotateCredentialsOptions struct {
+	ServiceName string
+	Restage     bool `long:"restage" description:"Restage the apps bound to the service so they pick up the new credentials." required:"false"`
+}
+
+func (a *AwsRdsRotateCredentialsOptions) SetServiceName(name string) {
+	a.ServiceName = name
+}
+
+func (c *BasicPlugin) AwsRdsRotateCredentialsRun(cliConnection plugin.CliConnection, args []string) error {
+	opts := AwsRdsRotateCredentialsOptions{}
+	err := c.getOptions(&opts, args)
+	if err != nil {
+		return err
+	}
+
+	current, err := c.getUPSCredentials(opts.ServiceName, cliConnection)
+	if err != nil {
+		c.UI.DisplayError(err)
+		return err
+	}
+	if current.RoleARN != "" {
+		err = fmt.Errorf("Error: service %s logs in with IAM auth tokens and holds no password to rotate", opts.ServiceName)
+		c.UI.DisplayError(err)
+		return err
+	}
+
+	instanceName, err := c.instanceName(cliConnection, opts.ServiceName)
+	if err != nil {
+		c.UI.DisplayError(err)
+		return err
+	}
+	instance, err := c.Api.DescribeInstance(instanceName)
+	if err != nil {
+		c.UI.DisplayError(err)
+		return err
+	}
+
+	password, err := api.GeneratePassword(instance.Engine)
+	if err != nil {
+		c.UI.DisplayError(err)
+		return err
+	}
+
+	c.UI.DisplayText("Changing the master password of RDS instance {{.ServiceName}}...", map[string]interface{}{
+		"ServiceName": opts.ServiceName,
+	})
+	err = c.resetPassword(opts.ServiceName, instance, password)
+	if err != nil {
+		c.UI.DisplayError(err)
+		return err
+	}
+
+	err = c.updateUPS(opts.ServiceName, instance, cliConnection)
+	if err != nil {
+		c.UI.DisplayError(err)
+		err = c.rollbackPassword(opts.ServiceName, instance, current.Password)
+		c.UI.DisplayError(err)
+		return err
+	}
+
+	c.UI.DisplayText("Successfully rotated the credentials of service {{.ServiceName}}", map[string]interface{}{
+		"ServiceName": opts.ServiceName,
+	})
+
+	if !opts.Restage {
+		c.UI.DisplayText("Restage the apps bound to {{.ServiceName}} for them to pick up the new credentials.", map[string]interface{}{
+			"ServiceName": opts.ServiceName,
+		})
+		return nil
+	}
+
+	return c.restageBoundApps(opts.ServiceName, cliConnection)
+}
+
+func (c *BasicPlugin) resetPassword(serviceName string, instance *api.DBInstance, password string) error {
+	resumeHint := fmt.Sprintf("RDS may still apply the new master password of instance %s; run `cf aws-rds-rotate-credentials %s` once it is available to bring the service back in sync.", instance.InstanceName, serviceName)
+	return c.runOperation(resumeHint, func(ctx context.Context) error {
+		return c.Api.ResetPassword(ctx, instance, password)
+	})
+}
+
+// rollbackPassword restores the password still stored in the user-provided
+// service after updating the service failed. The returned error describes
+// the state the instance and the service were left in, and is a cf CLI error
+// either way.
+func (c *BasicPlugin) rollbackPassword(serviceName string, instance *api.DBInstance, oldPassword string) error {
+	outOfSync := cfCLIError(fmt.Errorf("RDS instance %s has a new master password but service %s still holds the old credentials. Run `cf aws-rds-rotate-credentials %s` again to bring them back in sync.", instance.InstanceName, serviceName, serviceName))
+	if oldPassword == "" {
+		return outOfSync
+	}
+
+	c.UI.DisplayText("Could not update service {{.ServiceName}}, restoring the previous master password...", map[string]interface{}{
+		"ServiceName": serviceName,
+	})
+	err := c.resetPassword(serviceName, instance, oldPassword)
+	if err != nil {
+		return outOfSync
+	}
+
+	return cfCLIError(fmt.Errorf("Could not update service %s. The previous master password of RDS instance %s was restored.", serviceName, instance.InstanceName))
+}
+
+func (c *BasicPlugin) restageBoundApps(serviceName string, cli plugin.CliConnection) error {
+	services, err := cli.GetServices()
+	err = cfCLIError(err)
+	if err != nil {
+		c.UI.DisplayError(err)
+		return err
+	}
+
+	for _, service := range services {
+		if service.Name != serviceName {
+			continue
+		}
+
+		for _, appName := range service.ApplicationNames {
+			_, err = cli.CliCommand("restage", appName)
+			err = cfCLIError(err)
+			if err != nil {
+				c.UI.DisplayError(err)
+				return err
+			}
+		}
+	}
+
+	return nil
+}
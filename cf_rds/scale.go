@@ -0,0 +1,94 @@
+package cf_rds
+
+import (
+	"context"
+	"fmt"
+
+	"code.cloudfoundry.org/cli/cf/errors"
+	"code.cloudfoundry.org/cli/plugin"
+	"github.com/seattle-beach/cf-cli-rds-plugin/api"
+)
+
+type AwsRdsScaleOptions struct {
+	ServiceName      string
+	Class            string `long:"class" description:"The new RDS instance type class." required:"false"`
+	Storage          int64  `long:"size" description:"The new amount of storage in Gb. Storage can only grow." required:"false"`
+	IOPS             int64  `long:"iops" description:"The new amount of provisioned IOPS." required:"false"`
+	ApplyImmediately bool   `long:"apply-immediately" description:"Apply the changes now instead of during the next maintenance window." required:"false"`
+}
+
+func (a *AwsRdsScaleOptions) SetServiceName(name string) {
+	a.ServiceName = name
+}
+
+func (c *BasicPlugin) AwsRdsScaleRun(cliConnection plugin.CliConnection, args []string) error {
+	opts := AwsRdsScaleOptions{}
+	err := c.getOptions(&opts, args)
+	if err != nil {
+		return err
+	}
+
+	if opts.Class == "" && opts.Storage == 0 && opts.IOPS == 0 {
+		err = usageError(errors.New("Incorrect Usage: at least one of --class, --size or --iops must be given"))
+		c.UI.DisplayError(err)
+		return err
+	}
+
+	instanceName, err := c.instanceName(cliConnection, opts.ServiceName)
+	if err != nil {
+		c.UI.DisplayError(err)
+		return err
+	}
+
+	current, err := c.Api.DescribeInstance(instanceName)
+	if err != nil {
+		c.UI.DisplayError(err)
+		return err
+	}
+
+	if opts.Storage != 0 && opts.Storage < current.Storage {
+		err = fmt.Errorf("Error: RDS instances cannot shrink their storage; %s has %d GB", opts.ServiceName, current.Storage)
+		c.UI.DisplayError(err)
+		return err
+	}
+
+	dbInstance := &api.DBInstance{
+		InstanceName:  instanceName,
+		InstanceClass: opts.Class,
+		Storage:       opts.Storage,
+		IOPS:          opts.IOPS,
+	}
+
+	err = c.Api.ScaleInstance(dbInstance, opts.ApplyImmediately)
+	if err != nil {
+		c.UI.DisplayError(err)
+		return err
+	}
+	c.UI.DisplayText("Pending modifications: {{.Modifications}}", map[string]interface{}{
+		"Modifications": pendingModifications(dbInstance.PendingModifications),
+	})
+	if opts.ApplyImmediately {
+		c.UI.DisplayText("Scaling RDS Instance. This may take several minutes...")
+	}
+
+	err = c.runOperation(infoHint(opts.ServiceName), func(ctx context.Context) error {
+		return c.Api.WaitForScale(ctx, dbInstance, opts.ApplyImmediately)
+	})
+	if err != nil {
+		c.UI.DisplayError(err)
+		return err
+	}
+
+	if !opts.ApplyImmediately {
+		c.UI.DisplayText("The changes to service {{.ServiceName}} will be applied during the next maintenance window ({{.MaintenanceWindow}})", map[string]interface{}{
+			"ServiceName":       opts.ServiceName,
+			"MaintenanceWindow": current.MaintenanceWindow,
+		})
+		return nil
+	}
+
+	c.UI.DisplayText("Successfully scaled RDS instance {{.ServiceName}}", map[string]interface{}{
+		"ServiceName": opts.ServiceName,
+	})
+	return nil
+}
@@ -0,0 +1,95 @@
+package cf_rds_test
+
+import (
+	"code.cloudfoundry.org/cli/plugin/pluginfakes"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/seattle-beach/cf-cli-rds-plugin/api"
+	. "github.com/seattle-beach/cf-cli-rds-plugin/cf_rds"
+	"github.com/seattle-beach/cf-cli-rds-plugin/cf_rds/fakes"
+)
+
+var _ = Describe("scale", func() {
+	var ui MockUi
+	var conn *pluginfakes.FakeCliConnection
+	var fakeApi *fakes.FakeApi
+	var p *BasicPlugin
+
+	BeforeEach(func() {
+		conn = &pluginfakes.FakeCliConnection{}
+		ui = MockUi{}
+		fakeApi = &fakes.FakeApi{}
+
+		p = &BasicPlugin{
+			UI:  &ui,
+			Api: fakeApi,
+		}
+
+		fakeApi.DescribeInstanceReturns(&api.DBInstance{
+			InstanceName:      "name",
+			InstanceClass:     "db.t2.micro",
+			Storage:           20,
+			MaintenanceWindow: "sun:06:00-sun:06:30",
+		}, nil)
+		fakeApi.ScaleInstanceStub = func(instance *api.DBInstance, applyImmediately bool) error {
+			instance.PendingModifications = &rds.PendingModifiedValues{
+				DBInstanceClass:  aws.String("db.m4.large"),
+				AllocatedStorage: aws.Int64(50),
+			}
+			return nil
+		}
+	})
+
+	It("passes the requested changes to the API", func() {
+		p.Run(conn, []string{"aws-rds-scale", "name", "--class", "db.m4.large", "--size", "50", "--iops", "1000", "--apply-immediately"})
+		Expect(fakeApi.ScaleInstanceCallCount()).To(Equal(1))
+		instance, applyImmediately := fakeApi.ScaleInstanceArgsForCall(0)
+		Expect(instance.InstanceName).To(Equal("name"))
+		Expect(instance.InstanceClass).To(Equal("db.m4.large"))
+		Expect(instance.Storage).To(Equal(int64(50)))
+		Expect(instance.IOPS).To(Equal(int64(1000)))
+		Expect(applyImmediately).To(BeTrue())
+	})
+
+	It("waits for the changes to be applied", func() {
+		p.Run(conn, []string{"aws-rds-scale", "name", "--class", "db.m4.large", "--apply-immediately"})
+		Expect(fakeApi.WaitForScaleCallCount()).To(Equal(1))
+		_, instance, applyImmediately := fakeApi.WaitForScaleArgsForCall(0)
+		Expect(instance.InstanceName).To(Equal("name"))
+		Expect(applyImmediately).To(BeTrue())
+	})
+
+	It("reports the pending modifications and waits for the changes", func() {
+		err := p.AwsRdsScaleRun(conn, []string{"aws-rds-scale", "name", "--class", "db.m4.large", "--apply-immediately"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ui.Texts[0]).To(Equal("Pending modifications: {{.Modifications}}"))
+		Expect(ui.TextData[0]).To(HaveKeyWithValue("Modifications", "class db.m4.large, storage 50 GB"))
+		Expect(ui.TextTemplate).To(Equal("Successfully scaled RDS instance {{.ServiceName}}"))
+	})
+
+	It("points to the maintenance window without --apply-immediately", func() {
+		p.Run(conn, []string{"aws-rds-scale", "name", "--class", "db.m4.large"})
+		_, applyImmediately := fakeApi.ScaleInstanceArgsForCall(0)
+		Expect(applyImmediately).To(BeFalse())
+		Expect(ui.TextTemplate).To(Equal("The changes to service {{.ServiceName}} will be applied during the next maintenance window ({{.MaintenanceWindow}})"))
+		Expect(ui.Data).To(HaveKeyWithValue("MaintenanceWindow", "sun:06:00-sun:06:30"))
+	})
+
+	Context("error cases", func() {
+		It("requires at least one change", func() {
+			err := p.AwsRdsScaleRun(conn, []string{"aws-rds-scale", "name"})
+			Expect(err).To(MatchError("Incorrect Usage: at least one of --class, --size or --iops must be given"))
+			Expect(fakeApi.ScaleInstanceCallCount()).To(Equal(0))
+		})
+
+		It("rejects shrinking the storage", func() {
+			err := p.AwsRdsScaleRun(conn, []string{"aws-rds-scale", "name", "--size", "10"})
+			Expect(err).To(MatchError("Error: RDS instances cannot shrink their storage; name has 20 GB"))
+			Expect(ui.Err).To(Equal(err))
+			Expect(ExitCode(err)).To(Equal(ExitFailure))
+			Expect(fakeApi.ScaleInstanceCallCount()).To(Equal(0))
+		})
+	})
+})
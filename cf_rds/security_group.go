@@ -0,0 +1,30 @@
+package cf_rds
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/seattle-beach/cf-cli-rds-plugin/api"
+)
+
+// createSecurityGroup creates a security group in the VPC of the instance
+// that only admits the CIDRs on the default port of its engine. The group
+// carries the tags of the instance.
+func (c *BasicPlugin) createSecurityGroup(instance *api.DBInstance, cidrs []string) (string, error) {
+	engine, err := api.LookupEngine(instance.Engine)
+	if err != nil {
+		return "", err
+	}
+
+	groupID, err := c.Api.CreateSecurityGroup(instance.InstanceName, aws.StringValue(instance.SubnetGroup.VpcId), engine.DefaultPort, cidrs, instance.Tags)
+	if err != nil {
+		return "", err
+	}
+
+	c.UI.DisplayText("Created security group {{.GroupID}} admitting {{.CIDRs}} on port {{.Port}}", map[string]interface{}{
+		"GroupID": groupID,
+		"CIDRs":   strings.Join(cidrs, ", "),
+		"Port":    engine.DefaultPort,
+	})
+	return groupID, nil
+}
@@ -0,0 +1,155 @@
+package cf_rds
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/cli/plugin"
+)
+
+type AwsRdsSnapshotOptions struct {
+	ServiceName string
+	Name        string `long:"name" description:"The name of the DB snapshot. Defaults to the name of the RDS instance followed by the current time." required:"false"`
+}
+
+func (a *AwsRdsSnapshotOptions) SetServiceName(name string) {
+	a.ServiceName = name
+}
+
+func (c *BasicPlugin) AwsRdsSnapshotRun(cliConnection plugin.CliConnection, args []string) error {
+	opts := AwsRdsSnapshotOptions{}
+	err := c.getOptions(&opts, args)
+	if err != nil {
+		return err
+	}
+
+	instanceName, err := c.instanceName(cliConnection, opts.ServiceName)
+	if err != nil {
+		c.UI.DisplayError(err)
+		return err
+	}
+
+	snapshotName := opts.Name
+	if snapshotName == "" {
+		snapshotName = instanceName + "-" + time.Now().UTC().Format("2006-01-02-15-04-05")
+	}
+
+	c.UI.DisplayText("Creating DB snapshot {{.SnapshotName}}. This may take several minutes...", map[string]interface{}{
+		"SnapshotName": snapshotName,
+	})
+	resumeHint := fmt.Sprintf("RDS keeps working on DB snapshot %s; check its status with `cf aws-rds-snapshots %s`.", snapshotName, opts.ServiceName)
+	err = c.runOperation(resumeHint, func(ctx context.Context) error {
+		return c.Api.CreateSnapshot(ctx, instanceName, snapshotName)
+	})
+	if err != nil {
+		c.UI.DisplayError(err)
+		return err
+	}
+
+	c.UI.DisplayText("Successfully created DB snapshot {{.SnapshotName}} of service {{.ServiceName}}", map[string]interface{}{
+		"SnapshotName": snapshotName,
+		"ServiceName":  opts.ServiceName,
+	})
+	return nil
+}
+
+type AwsRdsSnapshotsOptions struct {
+	ServiceName string
+}
+
+func (a *AwsRdsSnapshotsOptions) SetServiceName(name string) {
+	a.ServiceName = name
+}
+
+func (c *BasicPlugin) AwsRdsSnapshotsRun(cliConnection plugin.CliConnection, args []string) error {
+	opts := AwsRdsSnapshotsOptions{}
+	err := c.getOptions(&opts, args)
+	if err != nil {
+		return err
+	}
+
+	instanceName, err := c.instanceName(cliConnection, opts.ServiceName)
+	if err != nil {
+		c.UI.DisplayError(err)
+		return err
+	}
+
+	snapshots, err := c.Api.ListSnapshots(instanceName)
+	if err != nil {
+		c.UI.DisplayError(err)
+		return err
+	}
+
+	if c.machineOutput() {
+		output := []SnapshotOutput{}
+		for _, snapshot := range snapshots {
+			output = append(output, newSnapshotOutput(snapshot))
+		}
+		return c.displayDocument("snapshots", output)
+	}
+
+	if len(snapshots) == 0 {
+		c.UI.DisplayText("No DB snapshots found for service {{.ServiceName}}.", map[string]interface{}{
+			"ServiceName": opts.ServiceName,
+		})
+		return nil
+	}
+
+	table := [][]string{{"name", "type", "status", "created", "engine", "storage"}}
+	for _, snapshot := range snapshots {
+		created := ""
+		if !snapshot.Created.IsZero() {
+			created = snapshot.Created.Format(time.RFC3339)
+		}
+		table = append(table, []string{snapshot.Name, snapshot.Type, snapshot.Status, created, snapshot.Engine, strconv.FormatInt(snapshot.Storage, 10) + " GB"})
+	}
+
+	c.UI.DisplayTableWithHeader("", table, 3)
+	return nil
+}
+
+type AwsRdsDeleteSnapshotOptions struct {
+	SnapshotName string
+	Force        bool `short:"f" description:"Force deletion without confirmation." required:"false"`
+}
+
+// SetServiceName sets the snapshot name, the only positional argument of
+// aws-rds-delete-snapshot.
+func (a *AwsRdsDeleteSnapshotOptions) SetServiceName(name string) {
+	a.SnapshotName = name
+}
+
+func (c *BasicPlugin) AwsRdsDeleteSnapshotRun(cliConnection plugin.CliConnection, args []string) error {
+	opts := AwsRdsDeleteSnapshotOptions{}
+	err := c.getOptions(&opts, args)
+	if err != nil {
+		return err
+	}
+
+	if !opts.Force {
+		confirmed, err := c.UI.DisplayBoolPrompt(false, "Really delete the DB snapshot {{.SnapshotName}}?", map[string]interface{}{
+			"SnapshotName": opts.SnapshotName,
+		})
+		if err != nil {
+			c.UI.DisplayError(err)
+			return err
+		}
+		if !confirmed {
+			c.UI.DisplayText("Delete cancelled")
+			return nil
+		}
+	}
+
+	err = c.Api.DeleteSnapshot(opts.SnapshotName)
+	if err != nil {
+		c.UI.DisplayError(err)
+		return err
+	}
+
+	c.UI.DisplayText("Successfully deleted DB snapshot {{.SnapshotName}}", map[string]interface{}{
+		"SnapshotName": opts.SnapshotName,
+	})
+	return nil
+}
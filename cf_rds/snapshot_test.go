@@ -0,0 +1,135 @@
+package cf_rds_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/plugin/pluginfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/seattle-beach/cf-cli-rds-plugin/api"
+	. "github.com/seattle-beach/cf-cli-rds-plugin/cf_rds"
+	"github.com/seattle-beach/cf-cli-rds-plugin/cf_rds/fakes"
+)
+
+var _ = Describe("snapshots", func() {
+	var ui MockUi
+	var conn *pluginfakes.FakeCliConnection
+	var fakeApi *fakes.FakeApi
+	var p *BasicPlugin
+
+	BeforeEach(func() {
+		conn = &pluginfakes.FakeCliConnection{}
+		ui = MockUi{}
+		fakeApi = &fakes.FakeApi{}
+
+		p = &BasicPlugin{
+			UI:  &ui,
+			Api: fakeApi,
+		}
+	})
+
+	Describe("aws-rds-snapshot", func() {
+		It("snapshots the service's instance under the given name", func() {
+			err := p.AwsRdsSnapshotRun(conn, []string{"aws-rds-snapshot", "name", "--name", "before-upgrade"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeApi.CreateSnapshotCallCount()).To(Equal(1))
+			_, instanceName, snapshotName := fakeApi.CreateSnapshotArgsForCall(0)
+			Expect(instanceName).To(Equal("name"))
+			Expect(snapshotName).To(Equal("before-upgrade"))
+			Expect(ui.TextTemplate).To(Equal("Successfully created DB snapshot {{.SnapshotName}} of service {{.ServiceName}}"))
+		})
+
+		It("names the snapshot after the instance and the current time by default", func() {
+			p.Run(conn, []string{"aws-rds-snapshot", "name"})
+			_, _, snapshotName := fakeApi.CreateSnapshotArgsForCall(0)
+			Expect(snapshotName).To(MatchRegexp(`^name-\d{4}-\d{2}-\d{2}-\d{2}-\d{2}-\d{2}$`))
+		})
+
+		It("names the snapshot after the instance rather than a service name RDS would not accept", func() {
+			fakeApi.FindInstanceReturns(&api.DBInstance{InstanceName: "my-db"}, nil)
+			p.Run(conn, []string{"aws-rds-snapshot", "my_db"})
+			_, instanceName, snapshotName := fakeApi.CreateSnapshotArgsForCall(0)
+			Expect(instanceName).To(Equal("my-db"))
+			Expect(snapshotName).To(MatchRegexp(`^my-db-\d{4}-\d{2}-\d{2}-\d{2}-\d{2}-\d{2}$`))
+		})
+
+		It("prints progress until the snapshot is available", func() {
+			fakeApi.CreateSnapshotStub = func(ctx context.Context, instanceName string, snapshotName string) error {
+				time.Sleep(5 * time.Millisecond)
+				return nil
+			}
+			p.Run(conn, []string{"aws-rds-snapshot", "name"})
+			Expect(ui.TextTemplate).To(Equal("Successfully created DB snapshot {{.SnapshotName}} of service {{.ServiceName}}"))
+		})
+
+		It("displays the error if the snapshot fails", func() {
+			fakeApi.CreateSnapshotReturns(errors.New("DB snapshot name-1 is failed"))
+			err := p.AwsRdsSnapshotRun(conn, []string{"aws-rds-snapshot", "name"})
+			Expect(err).To(MatchError("DB snapshot name-1 is failed"))
+			Expect(ui.Err).To(Equal(err))
+		})
+	})
+
+	Describe("aws-rds-snapshots", func() {
+		It("lists the snapshots of the service's instance", func() {
+			fakeApi.ListSnapshotsReturns([]*api.DBSnapshot{{
+				Name:    "before-upgrade",
+				Type:    "manual",
+				Status:  "available",
+				Created: time.Date(2017, 10, 1, 12, 0, 0, 0, time.UTC),
+				Engine:  "postgres",
+				Storage: 20,
+			}}, nil)
+
+			err := p.AwsRdsSnapshotsRun(conn, []string{"aws-rds-snapshots", "name"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeApi.ListSnapshotsArgsForCall(0)).To(Equal("name"))
+			Expect(ui.HeaderTable).To(Equal([][]string{
+				{"name", "type", "status", "created", "engine", "storage"},
+				{"before-upgrade", "manual", "available", "2017-10-01T12:00:00Z", "postgres", "20 GB"},
+			}))
+		})
+
+		It("writes the snapshots as yaml with --output yaml", func() {
+			fakeApi.ListSnapshotsReturns([]*api.DBSnapshot{{
+				Name:         "before-upgrade",
+				InstanceName: "name",
+				Status:       "available",
+				Storage:      20,
+			}}, nil)
+
+			p.Run(conn, []string{"aws-rds-snapshots", "name", "--output", "yaml"})
+			Expect(ui.HeaderTable).To(BeNil())
+			Expect(ui.Out.String()).To(Equal("schema_version: 1\nsnapshots:\n- name: before-upgrade\n  instance: name\n  status: available\n  storage_gb: 20\n"))
+		})
+
+		It("says so when there are no snapshots", func() {
+			fakeApi.ListSnapshotsReturns([]*api.DBSnapshot{}, nil)
+			p.Run(conn, []string{"aws-rds-snapshots", "name"})
+			Expect(ui.TextTemplate).To(Equal("No DB snapshots found for service {{.ServiceName}}."))
+		})
+	})
+
+	Describe("aws-rds-delete-snapshot", func() {
+		It("asks for confirmation before deleting", func() {
+			ui.PromptResponse = false
+			p.Run(conn, []string{"aws-rds-delete-snapshot", "before-upgrade"})
+			Expect(ui.PromptTemplate).To(Equal("Really delete the DB snapshot {{.SnapshotName}}?"))
+			Expect(fakeApi.DeleteSnapshotCallCount()).To(Equal(0))
+		})
+
+		It("deletes the snapshot with -f", func() {
+			err := p.AwsRdsDeleteSnapshotRun(conn, []string{"aws-rds-delete-snapshot", "before-upgrade", "-f"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeApi.DeleteSnapshotArgsForCall(0)).To(Equal("before-upgrade"))
+			Expect(ui.TextTemplate).To(Equal("Successfully deleted DB snapshot {{.SnapshotName}}"))
+		})
+
+		It("displays usage without a snapshot name", func() {
+			p.Run(conn, []string{"aws-rds-delete-snapshot"})
+			Expect(ui.Data["Usage"]).To(HavePrefix("cf aws-rds-delete-snapshot"))
+		})
+	})
+})
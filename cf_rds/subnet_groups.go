@@ -0,0 +1,126 @@
+package cf_rds
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/cli/plugin"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/seattle-beach/cf-cli-rds-plugin/api"
+)
+
+type AwsRdsSubnetGroupsOptions struct {
+	VPC  string   `long:"vpc" value-name:"ID" description:"Only list the DB subnet groups in this VPC." required:"false"`
+	Tags []string `long:"tag" value-name:"KEY=VALUE" description:"Only list the DB subnet groups carrying this tag; can be given more than once." required:"false"`
+}
+
+func (c *BasicPlugin) AwsRdsSubnetGroupsRun(cliConnection plugin.CliConnection, args []string) error {
+	opts := AwsRdsSubnetGroupsOptions{}
+	err := c.getOptionsWithoutArgs(&opts, args)
+	if err != nil {
+		return err
+	}
+
+	tags, err := parseTags(opts.Tags)
+	if err != nil {
+		c.UI.DisplayError(err)
+		return err
+	}
+	filter := api.SubnetGroupFilter{
+		VPC:  opts.VPC,
+		Tags: tags,
+	}
+
+	subnetGroups, err := c.Api.GetSubnetGroups(filter)
+	if err != nil {
+		c.UI.DisplayError(err)
+		return err
+	}
+
+	entries := []SubnetGroupOutput{}
+	for _, subnetGroup := range subnetGroups {
+		entries = append(entries, newSubnetGroupOutput(subnetGroup))
+	}
+
+	if c.machineOutput() {
+		return c.displayDocument("subnet_groups", entries)
+	}
+
+	if len(entries) == 0 {
+		c.UI.DisplayText("No DB subnet groups found")
+		return nil
+	}
+
+	table := [][]string{{"name", "vpc", "status", "availability zones", "active subnets"}}
+	for _, entry := range entries {
+		table = append(table, []string{
+			entry.Name,
+			entry.VPC,
+			entry.Status,
+			strings.Join(entry.AvailabilityZones, ", "),
+			fmt.Sprintf("%d of %d", entry.ActiveSubnets, len(entry.Subnets)),
+		})
+	}
+	c.UI.DisplayTableWithHeader("", table, 3)
+	return nil
+}
+
+// chooseSubnetGroup returns the DB subnet group named name or, without a
+// name, the only subnet group in the VPC, asking the user to pick one if
+// there are several. An empty VPC stands for every VPC.
+func (c *BasicPlugin) chooseSubnetGroup(name string, vpc string) (*api.SubnetGroup, error) {
+	filter := api.SubnetGroupFilter{VPC: vpc}
+	if name != "" {
+		filter.VPC = ""
+	}
+	subnetGroups, err := c.Api.GetSubnetGroups(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := []*api.SubnetGroup{}
+	for _, subnetGroup := range subnetGroups {
+		if name != "" && aws.StringValue(subnetGroup.DBSubnetGroupName) != name {
+			continue
+		}
+		if vpc != "" && aws.StringValue(subnetGroup.VpcId) != vpc {
+			if name == "" {
+				continue
+			}
+			return nil, usageError(fmt.Errorf("Incorrect Usage: DB subnet group %s is not in VPC %s", name, vpc))
+		}
+		candidates = append(candidates, subnetGroup)
+	}
+
+	var subnetGroup *api.SubnetGroup
+	switch {
+	case len(candidates) == 0 && name != "":
+		return nil, fmt.Errorf("Error: did not find DB subnet group %s", name)
+	case len(candidates) == 0 && vpc != "":
+		return nil, fmt.Errorf("Error: did not find any DB subnet groups in VPC %s", vpc)
+	case len(candidates) == 0:
+		return nil, errors.New("Error: did not find any DB subnet groups to create RDS instance in")
+	case len(candidates) == 1:
+		subnetGroup = candidates[0]
+	default:
+		choices := []string{}
+		for _, candidate := range candidates {
+			choices = append(choices, fmt.Sprintf("%s (%s, %s)", aws.StringValue(candidate.DBSubnetGroupName), aws.StringValue(candidate.VpcId), strings.Join(candidate.AvailabilityZones, ", ")))
+		}
+		index, err := c.UI.DisplayChoicePrompt("Which DB subnet group should the RDS instance be created in?", choices)
+		if err != nil {
+			return nil, err
+		}
+		if index < 0 || index >= len(candidates) {
+			return nil, errors.New("Error: no DB subnet group chosen")
+		}
+		subnetGroup = candidates[index]
+	}
+
+	if len(subnetGroup.AvailabilityZones) < 2 {
+		return nil, fmt.Errorf("Error: DB subnet group %s has active subnets in %d availability zone(s); RDS requires at least two", aws.StringValue(subnetGroup.DBSubnetGroupName), len(subnetGroup.AvailabilityZones))
+	}
+
+	return subnetGroup, nil
+}
@@ -0,0 +1,125 @@
+package cf_rds_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/plugin/pluginfakes"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/seattle-beach/cf-cli-rds-plugin/api"
+	. "github.com/seattle-beach/cf-cli-rds-plugin/cf_rds"
+	"github.com/seattle-beach/cf-cli-rds-plugin/cf_rds/fakes"
+)
+
+var _ = Describe("subnet groups", func() {
+	var ui MockUi
+	var conn *pluginfakes.FakeCliConnection
+	var fakeApi *fakes.FakeApi
+	var p *BasicPlugin
+	var args []string
+
+	BeforeEach(func() {
+		conn = &pluginfakes.FakeCliConnection{}
+		ui = MockUi{}
+		fakeApi = &fakes.FakeApi{}
+
+		p = &BasicPlugin{
+			UI:  &ui,
+			Api: fakeApi,
+		}
+		args = []string{"aws-rds-subnet-groups"}
+
+		fakeApi.GetSubnetGroupsReturns([]*api.SubnetGroup{
+			{
+				DBSubnetGroup: &rds.DBSubnetGroup{
+					DBSubnetGroupDescription: aws.String("Created from the RDS Management Console"),
+					DBSubnetGroupName:        aws.String("default-vpc-vpcid"),
+					SubnetGroupStatus:        aws.String("Complete"),
+					Subnets: []*rds.Subnet{
+						{
+							SubnetAvailabilityZone: &rds.AvailabilityZone{Name: aws.String("us-east-1d")},
+							SubnetIdentifier:       aws.String("subnet-dc92a7b9"),
+							SubnetStatus:           aws.String("Active"),
+						},
+						{
+							SubnetAvailabilityZone: &rds.AvailabilityZone{Name: aws.String("us-east-1e")},
+							SubnetIdentifier:       aws.String("subnet-4e3f2a1b"),
+							SubnetStatus:           aws.String("Inactive"),
+						},
+					},
+					VpcId: aws.String("vpcid"),
+				},
+				AvailabilityZones: []string{"us-east-1d"},
+				ActiveSubnets:     1,
+			},
+		}, nil)
+	})
+
+	It("lists the DB subnet groups with the availability zones of their active subnets", func() {
+		p.Run(conn, args)
+		Expect(fakeApi.GetSubnetGroupsArgsForCall(0)).To(Equal(api.SubnetGroupFilter{Tags: map[string]string{}}))
+		Expect(ui.HeaderTable).To(Equal([][]string{
+			{"name", "vpc", "status", "availability zones", "active subnets"},
+			{"default-vpc-vpcid", "vpcid", "Complete", "us-east-1d", "1 of 2"},
+		}))
+	})
+
+	It("passes --vpc and --tag on as the filter", func() {
+		p.Run(conn, append(args, "--vpc", "vpcid", "--tag", "team=data", "--tag", "tier=private"))
+		Expect(fakeApi.GetSubnetGroupsArgsForCall(0)).To(Equal(api.SubnetGroupFilter{
+			VPC:  "vpcid",
+			Tags: map[string]string{"team": "data", "tier": "private"},
+		}))
+	})
+
+	It("writes the subnet groups as json with --output json", func() {
+		p.Run(conn, append(args, "--output", "json"))
+		Expect(ui.HeaderTable).To(BeNil())
+		Expect(ui.Out.String()).To(MatchJSON(`{
+			"schema_version": 1,
+			"subnet_groups": [
+				{
+					"name": "default-vpc-vpcid",
+					"description": "Created from the RDS Management Console",
+					"vpc": "vpcid",
+					"status": "Complete",
+					"availability_zones": ["us-east-1d"],
+					"active_subnets": 1,
+					"subnets": [
+						{"id": "subnet-dc92a7b9", "availability_zone": "us-east-1d", "status": "Active"},
+						{"id": "subnet-4e3f2a1b", "availability_zone": "us-east-1e", "status": "Inactive"}
+					]
+				}
+			]
+		}`))
+	})
+
+	It("says so if there are no DB subnet groups", func() {
+		fakeApi.GetSubnetGroupsReturns([]*api.SubnetGroup{}, nil)
+		p.Run(conn, args)
+		Expect(ui.HeaderTable).To(BeNil())
+		Expect(ui.TextTemplate).To(Equal("No DB subnet groups found"))
+	})
+
+	Context("error cases", func() {
+		It("rejects tags not given as KEY=VALUE", func() {
+			exitCode := 0
+			p.Exit = func(code int) {
+				exitCode = code
+			}
+			p.Run(conn, append(args, "--tag", "team"))
+			Expect(ui.Err).To(MatchError("Incorrect Usage: --tag must be given as KEY=VALUE, not team"))
+			Expect(exitCode).To(Equal(ExitUsage))
+			Expect(fakeApi.GetSubnetGroupsCallCount()).To(Equal(0))
+		})
+
+		It("displays the error if listing the DB subnet groups fails", func() {
+			fakeApi.GetSubnetGroupsReturns(nil, errors.New("boom"))
+			err := p.AwsRdsSubnetGroupsRun(conn, args)
+			Expect(err).To(MatchError("boom"))
+			Expect(ui.Err).To(MatchError("boom"))
+		})
+	})
+})
@@ -0,0 +1,100 @@
+package cf_rds
+
+import (
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/cli/plugin"
+	"github.com/seattle-beach/cf-cli-rds-plugin/api"
+)
+
+// Version is the version of the plugin, which it tags the resources it
+// creates with.
+var Version = plugin.VersionType{
+	Major: 1,
+	Minor: 0,
+	Build: 0,
+}
+
+// parseTags parses tags given as KEY=VALUE.
+func parseTags(tags []string) (map[string]string, error) {
+	parsed := map[string]string{}
+	for _, tag := range tags {
+		parts := strings.SplitN(tag, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, usageError(fmt.Errorf("Incorrect Usage: --tag must be given as KEY=VALUE, not %s", tag))
+		}
+		parsed[parts[0]] = parts[1]
+	}
+	return parsed, nil
+}
+
+// resourceTags returns the tags of the resources created for the service:
+// the tags given with --tag along with those tracing the resources back to
+// the service and the CF space it lives in.
+func (c *BasicPlugin) resourceTags(cliConnection plugin.CliConnection, serviceName string, userTags []string) (map[string]string, error) {
+	tags, err := parseTags(userTags)
+	if err != nil {
+		return nil, err
+	}
+	for key := range tags {
+		if strings.HasPrefix(key, api.TagPrefix) {
+			return nil, usageError(fmt.Errorf("Incorrect Usage: tags starting with %s are set by the plugin, not %s", api.TagPrefix, key))
+		}
+	}
+
+	endpoint, err := cliConnection.ApiEndpoint()
+	if err != nil {
+		return nil, cfCLIError(err)
+	}
+	org, err := cliConnection.GetCurrentOrg()
+	if err != nil {
+		return nil, cfCLIError(err)
+	}
+	space, err := cliConnection.GetCurrentSpace()
+	if err != nil {
+		return nil, cfCLIError(err)
+	}
+
+	tags[api.ServiceTag] = serviceName
+	tags[api.APIEndpointTag] = endpoint
+	tags[api.OrgTag] = org.Name
+	tags[api.OrgGUIDTag] = org.Guid
+	tags[api.SpaceTag] = space.Name
+	tags[api.SpaceGUIDTag] = space.Guid
+	tags[api.PluginVersionTag] = fmt.Sprintf("%d.%d.%d", Version.Major, Version.Minor, Version.Build)
+	return tags, nil
+}
+
+// serviceTags returns the tags the resources created for the service in the
+// current space carry.
+func serviceTags(cliConnection plugin.CliConnection, serviceName string) (map[string]string, error) {
+	space, err := cliConnection.GetCurrentSpace()
+	if err != nil {
+		return nil, cfCLIError(err)
+	}
+	return map[string]string{
+		api.ServiceTag:   serviceName,
+		api.SpaceGUIDTag: space.Guid,
+	}, nil
+}
+
+// instanceName returns the name of the RDS instance of the service: the
+// instance tagged with the service and the current space or, for instances
+// created before the plugin tagged them, the untagged instance named after
+// the service.
+func (c *BasicPlugin) instanceName(cliConnection plugin.CliConnection, serviceName string) (string, error) {
+	tags, err := serviceTags(cliConnection, serviceName)
+	if err != nil {
+		return "", err
+	}
+
+	instance, err := c.Api.FindInstance(serviceName, tags)
+	if err != nil {
+		return "", err
+	}
+	if instance == nil {
+		return serviceName, nil
+	}
+	return instance.InstanceName, nil
+}
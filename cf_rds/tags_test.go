@@ -0,0 +1,160 @@
+package cf_rds_test
+
+import (
+	"context"
+	"errors"
+
+	"code.cloudfoundry.org/cli/plugin/models"
+	"code.cloudfoundry.org/cli/plugin/pluginfakes"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/seattle-beach/cf-cli-rds-plugin/api"
+	. "github.com/seattle-beach/cf-cli-rds-plugin/cf_rds"
+	"github.com/seattle-beach/cf-cli-rds-plugin/cf_rds/fakes"
+)
+
+var _ = Describe("tags", func() {
+	var ui MockUi
+	var conn *pluginfakes.FakeCliConnection
+	var fakeApi *fakes.FakeApi
+	var p *BasicPlugin
+
+	BeforeEach(func() {
+		conn = &pluginfakes.FakeCliConnection{}
+		ui = MockUi{}
+		fakeApi = &fakes.FakeApi{}
+
+		p = &BasicPlugin{
+			UI:  &ui,
+			Api: fakeApi,
+		}
+
+		conn.ApiEndpointReturns("https://api.example.com", nil)
+		conn.GetCurrentOrgReturns(plugin_models.Organization{
+			OrganizationFields: plugin_models.OrganizationFields{
+				Guid: "org-guid",
+				Name: "org",
+			},
+		}, nil)
+		conn.GetCurrentSpaceReturns(plugin_models.Space{
+			SpaceFields: plugin_models.SpaceFields{
+				Guid: "space-guid",
+				Name: "dev",
+			},
+		}, nil)
+	})
+
+	Describe("aws-rds-create", func() {
+		var args []string
+
+		BeforeEach(func() {
+			args = []string{"aws-rds-create", "name"}
+			fakeApi.GetSubnetGroupsReturns([]*api.SubnetGroup{{
+				DBSubnetGroup: &rds.DBSubnetGroup{
+					DBSubnetGroupName: aws.String("default-vpc-vpcid"),
+					VpcId:             aws.String("vpcid"),
+				},
+				AvailabilityZones: []string{"us-east-1d", "us-east-1e"},
+				ActiveSubnets:     2,
+			}}, nil)
+			fakeApi.CreateInstanceStub = func(ctx context.Context, instance *api.DBInstance) error {
+				instance.SecGroups = []*rds.VpcSecurityGroupMembership{{VpcSecurityGroupId: aws.String("vpcgroup")}}
+				return nil
+			}
+		})
+
+		It("tags the instance with its service, CF space and the plugin version", func() {
+			p.Run(conn, args)
+
+			_, instance := fakeApi.CreateInstanceArgsForCall(0)
+			Expect(instance.Tags).To(Equal(map[string]string{
+				"cf-rds-service":        "name",
+				"cf-rds-api-endpoint":   "https://api.example.com",
+				"cf-rds-org":            "org",
+				"cf-rds-org-guid":       "org-guid",
+				"cf-rds-space":          "dev",
+				"cf-rds-space-guid":     "space-guid",
+				"cf-rds-plugin-version": "1.0.0",
+			}))
+		})
+
+		It("adds the tags given with --tag", func() {
+			p.Run(conn, append(args, "--tag", "team=data", "--tag", "cost-center=db=42"))
+
+			_, instance := fakeApi.CreateInstanceArgsForCall(0)
+			Expect(instance.Tags).To(HaveKeyWithValue("team", "data"))
+			Expect(instance.Tags).To(HaveKeyWithValue("cost-center", "db=42"))
+			Expect(instance.Tags).To(HaveKeyWithValue("cf-rds-service", "name"))
+		})
+
+		Context("error cases", func() {
+			var exitCode int
+
+			BeforeEach(func() {
+				exitCode = 0
+				p.Exit = func(code int) {
+					exitCode = code
+				}
+			})
+
+			It("rejects tags that are not KEY=VALUE", func() {
+				p.Run(conn, append(args, "--tag", "team"))
+				Expect(ui.Err).To(MatchError("Incorrect Usage: --tag must be given as KEY=VALUE, not team"))
+				Expect(exitCode).To(Equal(ExitUsage))
+				Expect(fakeApi.CreateInstanceCallCount()).To(Equal(0))
+			})
+
+			It("rejects tags the plugin sets itself", func() {
+				p.Run(conn, append(args, "--tag", "cf-rds-space=prod"))
+				Expect(ui.Err).To(MatchError("Incorrect Usage: tags starting with cf-rds- are set by the plugin, not cf-rds-space"))
+				Expect(exitCode).To(Equal(ExitUsage))
+			})
+
+			It("exits with ExitCFCLI when the CF space cannot be read", func() {
+				conn.GetCurrentSpaceReturns(plugin_models.Space{}, errors.New("not logged in"))
+				p.Run(conn, args)
+				Expect(ui.Err).To(MatchError("not logged in"))
+				Expect(exitCode).To(Equal(ExitCFCLI))
+				Expect(fakeApi.CreateInstanceCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("finding the instance of a service", func() {
+		It("looks for the instance tagged with the service and the current space", func() {
+			p.Run(conn, []string{"aws-rds-stop", "name"})
+
+			instanceName, tags := fakeApi.FindInstanceArgsForCall(0)
+			Expect(instanceName).To(Equal("name"))
+			Expect(tags).To(Equal(map[string]string{
+				"cf-rds-service":    "name",
+				"cf-rds-space-guid": "space-guid",
+			}))
+		})
+
+		It("uses the instance found by its tags", func() {
+			fakeApi.FindInstanceReturns(&api.DBInstance{InstanceName: "name-dev"}, nil)
+			p.Run(conn, []string{"aws-rds-stop", "name"})
+
+			_, instanceName := fakeApi.StopInstanceArgsForCall(0)
+			Expect(instanceName).To(Equal("name-dev"))
+			Expect(ui.Data["ServiceName"]).To(Equal("name"))
+		})
+
+		It("falls back to the instance named after the service", func() {
+			p.Run(conn, []string{"aws-rds-stop", "name"})
+
+			_, instanceName := fakeApi.StopInstanceArgsForCall(0)
+			Expect(instanceName).To(Equal("name"))
+		})
+
+		It("displays the error if the lookup fails", func() {
+			fakeApi.FindInstanceReturns(nil, errors.New("NoCredentialProviders"))
+			err := p.AwsRdsStopRun(conn, []string{"aws-rds-stop", "name"})
+			Expect(err).To(MatchError("NoCredentialProviders"))
+			Expect(fakeApi.StopInstanceCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -5,12 +5,9 @@ import (
 	"github.com/seattle-beach/cf-cli-rds-plugin/cf_rds"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
-	"github.com/aws/aws-sdk-go/aws/session"
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/rds"
-	"github.com/aws/aws-sdk-go/aws/endpoints"
-	"time"
 	"github.com/seattle-beach/cf-cli-rds-plugin/api"
+	"github.com/mattn/go-isatty"
+	"os"
 )
 
 type MyConfig struct {}
@@ -24,7 +21,7 @@ func (c MyConfig) Locale() string {
 }
 
 func (c MyConfig) IsTTY() bool {
-	return false
+	return isatty.IsTerminal(os.Stdout.Fd())
 }
 
 func (c MyConfig) TerminalWidth() int {
@@ -46,19 +43,16 @@ func main() {
 	// invoked.
 	config := MyConfig {}
 	my_ui, _ := ui.NewUI(&config)
-	sess := session.Must(session.NewSession(&aws.Config {
-		Region: aws.String(endpoints.UsEast1RegionID),
-	}))
-
-	svc := rds.New(sess)
-	cfrdsapi := &api.CfRDSApi{
-		Svc: svc,
-	}
-
 	rds_plugin := cf_rds.BasicPlugin{
-		UI: my_ui,
-		Api: cfrdsapi,
-		WaitDuration: time.Minute,
+		UI: &cf_rds.ProgressUI{UI: my_ui},
+		Exit: os.Exit,
+		NewApi: func(region string) (cf_rds.Api, error) {
+			cfrdsapi, err := api.NewCfRDSApi(region)
+			if err != nil {
+				return nil, err
+			}
+			return cfrdsapi, nil
+		},
 	}
 	plugin.Start(&rds_plugin)
 	// Plugin code should be written in the Run([]string) method,